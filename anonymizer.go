@@ -0,0 +1,104 @@
+package blanknode
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// AnonymizerEncoding is how an [Anonymizer] encodes the HMAC of a blank-node-label.
+type AnonymizerEncoding int
+
+const (
+	// AnonymizerBase64URL encodes the HMAC as "u" followed by base64url (without padding).
+	// For example, the blank-node-label "b0" anonymized with the key "secret" is:
+	//
+	//	u3tdptKlj-8k0AnuZW8KiNjig1Cfguu_89fIeV_pM4ts
+	//
+	// This is the default.
+	AnonymizerBase64URL AnonymizerEncoding = iota
+
+	// AnonymizerBase32 encodes the HMAC as "b" followed by lowercase base32 (without padding).
+	// For example, the blank-node-label "b0" anonymized with the key "secret" is:
+	//
+	//	b33lwtnfjmp54snacpomvxqvcgy4kbvbh4c5o77hv6ipfp6sm4lnq
+	AnonymizerBase32
+)
+
+// Anonymizer maps blank-node-labels and blank-node-identifiers to anonymized ones.
+//
+// The anonymized blank-node-label is derived from the HMAC-SHA-256 of the original blank-node-label, keyed with ‘Key’.
+// So:
+//
+// • the same blank-node-label, anonymized with the same ‘Key’, always results in the same anonymized blank-node-label,
+//
+// • the original blank-node-label cannot be recovered from the anonymized blank-node-label without ‘Key’.
+//
+// This makes it possible to publish datasets whose blank-node-labels leak information (such as user IDs or counters), while still having two datasets anonymized with the same ‘Key’ be joinable.
+//
+// For example:
+//
+//	var anonymizer blanknode.Anonymizer = blanknode.Anonymizer{
+//		Key: secret,
+//	}
+//
+//	// ...
+//
+//	anonymized, err := anonymizer.Identifier(identifier)
+//
+// The "u" and "b" prefixes are the same as the multibase prefixes for base64url and lowercase base32.
+// They also make sure that the anonymized blank-node-label does not begin with a "-", so that it is always valid under [ParseLabelString].
+type Anonymizer struct {
+	// Key is the secret key for the HMAC.
+	// It must not be empty.
+	Key []byte
+
+	// Encoding is how the HMAC is encoded into a blank-node-label.
+	Encoding AnonymizerEncoding
+}
+
+// Label returns the anonymized blank-node-label for ‘label’.
+func (receiver Anonymizer) Label(label Label) (Label, error) {
+	if len(receiver.Key) <= 0 {
+		return Label{}, ErrEmptyKey
+	}
+
+	value, found := label.Get()
+	if !found {
+		return Label{}, ErrEmptyLabel
+	}
+
+	var mac []byte
+	{
+		h := hmac.New(sha256.New, receiver.Key)
+		h.Write([]byte(value))
+		mac = h.Sum(nil)
+	}
+
+	switch receiver.Encoding {
+	case AnonymizerBase64URL:
+		return someLabel("u" + base64.RawURLEncoding.EncodeToString(mac)), nil
+	case AnonymizerBase32:
+		return someLabel("b" + hashLabelEncoding.EncodeToString(mac)), nil
+	default:
+		return Label{}, erorr.Errorf("anonymizer encoding %d: %w", receiver.Encoding, ErrUnknownEncoding)
+	}
+}
+
+// Identifier returns the anonymized blank-node-identifier for ‘identifier’.
+//
+// The anonymized blank-node-identifier is "_:" followed by the anonymized blank-node-label (see [Anonymizer.Label]).
+func (receiver Anonymizer) Identifier(identifier Identifier) (Identifier, error) {
+	if identifier.IsNothing() {
+		return Identifier{}, ErrEmptyIdentifier
+	}
+
+	label, err := receiver.Label(identifier.label)
+	if nil != err {
+		return Identifier{}, err
+	}
+
+	return someIdentifier(label), nil
+}
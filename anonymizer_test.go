@@ -0,0 +1,117 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+)
+
+func TestAnonymizer_Identifier(t *testing.T) {
+	tests := []struct {
+		Key                []byte
+		Encoding           AnonymizerEncoding
+		Identifier         Identifier
+		ExpectedIdentifier Identifier
+		ExpectedError      error
+	}{
+		{
+			Identifier:    someIdentifier(someLabel("b0")),
+			ExpectedError: ErrEmptyKey,
+		},
+		{
+			Key:           []byte("secret"),
+			ExpectedError: ErrEmptyIdentifier,
+		},
+		{
+			Key:           []byte("secret"),
+			Encoding:      AnonymizerEncoding(-1),
+			Identifier:    someIdentifier(someLabel("b0")),
+			ExpectedError: ErrUnknownEncoding,
+		},
+
+
+
+		{
+			Key:                []byte("secret"),
+			Encoding:           AnonymizerBase64URL,
+			Identifier:         someIdentifier(someLabel("b0")),
+			ExpectedIdentifier: someIdentifier(someLabel("u3tdptKlj-8k0AnuZW8KiNjig1Cfguu_89fIeV_pM4ts")),
+		},
+		{
+			Key:                []byte("secret"),
+			Encoding:           AnonymizerBase64URL,
+			Identifier:         someIdentifier(someLabel("b1")),
+			ExpectedIdentifier: someIdentifier(someLabel("uL49S1G64ebb_mWOwRggacM79t39BP0ZFg6LbWkRRL1o")),
+		},
+		{
+			Key:                []byte("other"),
+			Encoding:           AnonymizerBase64URL,
+			Identifier:         someIdentifier(someLabel("b0")),
+			ExpectedIdentifier: someIdentifier(someLabel("up9RRxeZ4rfXIbE_dk44G50S7HV7aKD-R6x5GW8RaQrQ")),
+		},
+
+
+
+		{
+			Key:                []byte("secret"),
+			Encoding:           AnonymizerBase32,
+			Identifier:         someIdentifier(someLabel("b0")),
+			ExpectedIdentifier: someIdentifier(someLabel("b33lwtnfjmp54snacpomvxqvcgy4kbvbh4c5o77hv6ipfp6sm4lnq")),
+		},
+		{
+			Key:                []byte("secret"),
+			Encoding:           AnonymizerBase32,
+			Identifier:         someIdentifier(someLabel("b1")),
+			ExpectedIdentifier: someIdentifier(someLabel("bf6hvfvdoxb43n74zmoyemca2odhp3n37ie7umrmdulnvurcrf5na")),
+		},
+	}
+
+	for testNumber, test := range tests {
+		var anonymizer Anonymizer = Anonymizer{
+			Key:      test.Key,
+			Encoding: test.Encoding,
+		}
+
+		actualIdentifier, actualError := anonymizer.Identifier(test.Identifier)
+		if nil == test.ExpectedError && nil != actualError {
+			t.Errorf("For test #%d, did not expect an error, but actually got one.", testNumber)
+			t.Logf("ERROR: %s", actualError)
+			t.Logf("IDENTIFIER: %q", test.Identifier)
+			continue
+		}
+		if nil != test.ExpectedError {
+			if !errors.Is(actualError, test.ExpectedError) {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED-ERROR: %s", test.ExpectedError)
+				t.Logf("ACTUAL-ERROR:   %s", actualError)
+				t.Logf("IDENTIFIER: %q", test.Identifier)
+			}
+			continue
+		}
+
+		{
+			expected := test.ExpectedIdentifier
+			actual := actualIdentifier
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual blank-node-identifier is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("IDENTIFIER: %q", test.Identifier)
+				continue
+			}
+		}
+
+		{
+			label, _ := actualIdentifier.Label()
+
+			_, err := ParseLabelString(label.String())
+			if nil != err {
+				t.Errorf("For test #%d, did not expect an error when parsing the anonymized blank-node-label, but actually got one.", testNumber)
+				t.Logf("ERROR: %s", err)
+				t.Logf("LABEL: %q", label)
+				continue
+			}
+		}
+	}
+}
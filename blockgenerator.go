@@ -0,0 +1,139 @@
+package blanknode
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultBlockSize is the block size a [BlockGenerator] uses if it is not given one.
+const DefaultBlockSize uint64 = 1024
+
+// BlockGenerator generates new blank-node-identifiers — a prefix followed by a counter — from many goroutines at the same time, without locks.
+//
+// Rather than every blank-node-identifier coming from a (contended) shared counter,
+// the shared counter hands out blocks of counter values (with atomics), and blank-node-identifiers are generated from a block without touching the shared counter.
+//
+// For the most speed, each goroutine should get its own [LocalBlockGenerator] (see [BlockGenerator.Local]).
+// [BlockGenerator.Identifier] and [BlockGenerator.Label] can also be called directly (from any goroutine) —
+// those use a [sync.Pool] of LocalBlockGenerators.
+//
+// Each blank-node-identifier is different, but (unlike [Generator]) they are not generated in order.
+//
+// The zero value is ready to use, and uses the prefix "b" and a block size of [DefaultBlockSize].
+type BlockGenerator struct {
+	prefix    string
+	blockSize uint64
+
+	next atomic.Uint64
+	pool sync.Pool
+}
+
+// NewBlockGenerator returns a new [BlockGenerator] that generates blank-node-identifiers with the prefix ‘prefix’, from blocks of ‘blockSize’ counter values.
+//
+// ‘prefix’ must be something that a blank-node-label can begin with.
+// If ‘blockSize’ is zero, then [DefaultBlockSize] is used.
+func NewBlockGenerator(prefix string, blockSize uint64) (*BlockGenerator, error) {
+	if err := validateGeneratorPrefix(prefix); nil != err {
+		return nil, err
+	}
+
+	return &BlockGenerator{
+		prefix:    prefix,
+		blockSize: blockSize,
+	}, nil
+}
+
+// Identifier returns a new blank-node-identifier.
+//
+// It is safe to call from multiple goroutines.
+func (receiver *BlockGenerator) Identifier() Identifier {
+	return someIdentifier(receiver.Label())
+}
+
+// Label returns a new blank-node-label.
+//
+// It is safe to call from multiple goroutines.
+func (receiver *BlockGenerator) Label() Label {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	local, _ := receiver.pool.Get().(*LocalBlockGenerator)
+	if nil == local {
+		local = receiver.Local()
+	}
+
+	var label Label = local.Label()
+	receiver.pool.Put(local)
+
+	return label
+}
+
+// Local returns a new [LocalBlockGenerator] — which is meant to be used by just one goroutine.
+func (receiver *BlockGenerator) Local() *LocalBlockGenerator {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	var prefix string = receiver.prefix
+	if "" == prefix {
+		prefix = DefaultGeneratorPrefix
+	}
+
+	var buffer []byte = make([]byte, 0, len(prefix)+20)
+	buffer = append(buffer, prefix...)
+
+	return &LocalBlockGenerator{
+		parent: receiver,
+		buffer: buffer,
+		prefix: len(prefix),
+	}
+}
+
+func (receiver *BlockGenerator) block() (uint64, uint64) {
+	var size uint64 = receiver.blockSize
+	if size <= 0 {
+		size = DefaultBlockSize
+	}
+
+	var end uint64 = receiver.next.Add(size)
+	return end - size, end
+}
+
+// LocalBlockGenerator generates new blank-node-identifiers from blocks of counter values that it gets from a [BlockGenerator].
+//
+// It is NOT safe to use from multiple goroutines — each goroutine should have its own.
+// (But any number of LocalBlockGenerators can share a BlockGenerator.)
+type LocalBlockGenerator struct {
+	parent *BlockGenerator
+	next   uint64
+	end    uint64
+
+	// buffer holds the prefix, followed by the space the number is formatted into.
+	buffer []byte
+	prefix int
+}
+
+// Identifier returns a new blank-node-identifier.
+func (receiver *LocalBlockGenerator) Identifier() Identifier {
+	return someIdentifier(receiver.Label())
+}
+
+// Label returns a new blank-node-label.
+func (receiver *LocalBlockGenerator) Label() Label {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	if receiver.end <= receiver.next {
+		receiver.next, receiver.end = receiver.parent.block()
+	}
+
+	var n uint64 = receiver.next
+	receiver.next++
+
+	receiver.buffer = strconv.AppendUint(receiver.buffer[:receiver.prefix], n, 10)
+
+	return someLabel(string(receiver.buffer))
+}
@@ -0,0 +1,154 @@
+package blanknode
+
+import (
+	"testing"
+
+	"strconv"
+	"sync"
+)
+
+func TestBlockGenerator(t *testing.T) {
+	generator, err := NewBlockGenerator("n", 4)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	a := generator.Local()
+	b := generator.Local()
+
+	// ‘a’ gets the block 0–3, and ‘b’ gets the block 4–7, and then ‘a’ gets the block 8–11.
+	var actual []string
+	for i := 0; i < 5; i++ {
+		actual = append(actual, a.Identifier().String())
+		if 0 == i {
+			actual = append(actual, b.Identifier().String())
+		}
+	}
+
+	expected := []string{"_:n0", "_:n4", "_:n1", "_:n2", "_:n3", "_:n8"}
+
+	if len(expected) != len(actual) {
+		t.Errorf("The actual blank-node-identifiers are not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+		return
+	}
+	for index := range expected {
+		if expected[index] != actual[index] {
+			t.Errorf("The actual blank-node-identifiers are not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			return
+		}
+	}
+}
+
+func TestBlockGenerator_concurrent(t *testing.T) {
+	const goroutines = 32
+	const count = 3000
+
+	var generator BlockGenerator
+
+	var results [goroutines][]Identifier
+
+	var waitGroup sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		waitGroup.Add(1)
+		go func(g int) {
+			defer waitGroup.Done()
+
+			var local *LocalBlockGenerator
+			if 0 == g%2 {
+				local = generator.Local()
+			}
+
+			for i := 0; i < count; i++ {
+				var identifier Identifier
+				if nil != local {
+					identifier = local.Identifier()
+				} else {
+					identifier = generator.Identifier()
+				}
+				results[g] = append(results[g], identifier)
+			}
+		}(g)
+	}
+	waitGroup.Wait()
+
+	var seen map[Identifier]struct{} = map[Identifier]struct{}{}
+	for _, identifiers := range results {
+		for _, identifier := range identifiers {
+			if _, found := seen[identifier]; found {
+				t.Errorf("Did not expect a duplicate blank-node-identifier, but actually got one: %s", identifier)
+				return
+			}
+			seen[identifier] = struct{}{}
+
+			if _, err := ParseLabelString(identifier.String()[len(IdentifierPrefix):]); nil != err {
+				t.Errorf("Expected the blank-node-identifier %s to be valid, but it actually was not.", identifier)
+				t.Logf("ERROR: (%T) %s", err, err)
+				return
+			}
+		}
+	}
+}
+
+func TestLocalBlockGenerator_allocations(t *testing.T) {
+	local := new(BlockGenerator).Local()
+
+	// The only allocation should be the string of the blank-node-label itself.
+	if expected, actual := 1.0, testing.AllocsPerRun(1000, func() { local.Identifier() }); expected < actual {
+		t.Errorf("The actual number of allocations per blank-node-identifier is not what was expected.")
+		t.Logf("EXPECTED: %v", expected)
+		t.Logf("ACTUAL:   %v", actual)
+	}
+}
+
+func benchmarkGenerators(b *testing.B, goroutines int, mint func() func() Identifier) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var waitGroup sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		waitGroup.Add(1)
+		go func(g int) {
+			defer waitGroup.Done()
+
+			var f func() Identifier = mint()
+			for n := g; n < b.N; n += goroutines {
+				f()
+			}
+		}(g)
+	}
+	waitGroup.Wait()
+}
+
+func BenchmarkGenerators(b *testing.B) {
+	for _, goroutines := range []int{1, 8, 32} {
+		b.Run("Generator/"+strconv.Itoa(goroutines), func(b *testing.B) {
+			var generator Generator
+			benchmarkGenerators(b, goroutines, func() func() Identifier {
+				return func() Identifier {
+					identifier, _ := generator.Identifier()
+					return identifier
+				}
+			})
+		})
+
+		b.Run("BlockGenerator/"+strconv.Itoa(goroutines), func(b *testing.B) {
+			var generator BlockGenerator
+			benchmarkGenerators(b, goroutines, func() func() Identifier {
+				return generator.Identifier
+			})
+		})
+
+		b.Run("LocalBlockGenerator/"+strconv.Itoa(goroutines), func(b *testing.B) {
+			var generator BlockGenerator
+			benchmarkGenerators(b, goroutines, func() func() Identifier {
+				return generator.Local().Identifier
+			})
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package blanknode
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// canonicalization is the result of canonicalizing a set of quads.
+type canonicalization struct {
+	// labels maps each blank-node to its canonical blank-node-label.
+	labels map[Identifier]string
+
+	// lines are the quads as N-Quads statements (using the canonical blank-node-labels), sorted.
+	lines []string
+}
+
+// digest returns the SHA-256 digest of the canonical N-Quads.
+func (receiver canonicalization) digest() []byte {
+	h := sha256.New()
+	for _, line := range receiver.lines {
+		io.WriteString(h, line)
+		h.Write([]byte{'\n'})
+	}
+
+	return h.Sum(nil)
+}
+
+// canonicalize returns canonical blank-node-labels for the blank-nodes in ‘quads’.
+//
+// The canonical blank-node-labels do not depend on the original blank-node-labels.
+// So two sets of quads that are the same (other than how their blank-nodes are labeled) end up with the same canonical N-Quads.
+//
+// This uses color refinement (where each blank-node is "colored" with a hash of the quads around it), and where that is not enough (because of symmetry), individualization — trying each of the blank-nodes that cannot be told apart, and keeping the result with the smallest canonical N-Quads.
+// Highly symmetric sets of quads can take exponential time.
+func canonicalize(quads []Quad) canonicalization {
+	var c canonicalizer = newCanonicalizer(quads)
+
+	var colors map[Identifier]string = map[Identifier]string{}
+	for _, identifier := range c.identifiers {
+		colors[identifier] = ""
+	}
+
+	return c.search(colors)
+}
+
+type canonicalizer struct {
+	quads       []Quad
+	identifiers []Identifier
+	mentions    map[Identifier][]int
+}
+
+func newCanonicalizer(quads []Quad) canonicalizer {
+	var c canonicalizer = canonicalizer{
+		mentions: map[Identifier][]int{},
+	}
+
+	{
+		var seen map[Quad]struct{} = map[Quad]struct{}{}
+		for _, quad := range quads {
+			if _, found := seen[quad]; found {
+				continue
+			}
+			seen[quad] = struct{}{}
+
+			c.quads = append(c.quads, quad)
+		}
+	}
+
+	for index, quad := range c.quads {
+		for _, identifier := range quad.BlankNodes() {
+			if _, found := c.mentions[identifier]; !found {
+				c.identifiers = append(c.identifiers, identifier)
+			}
+			c.mentions[identifier] = append(c.mentions[identifier], index)
+		}
+	}
+
+	return c
+}
+
+func colorHash(strs ...string) string {
+	h := sha256.New()
+	for _, str := range strs {
+		io.WriteString(h, str)
+		h.Write([]byte{'\n'})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func countColors(colors map[Identifier]string) int {
+	var distinct map[string]struct{} = map[string]struct{}{}
+	for _, color := range colors {
+		distinct[color] = struct{}{}
+	}
+
+	return len(distinct)
+}
+
+// refine re-colors each blank-node with a hash of its color and the quads it is in, until that does not split any more blank-nodes apart.
+func (receiver canonicalizer) refine(colors map[Identifier]string) map[Identifier]string {
+	for {
+		var next map[Identifier]string = make(map[Identifier]string, len(colors))
+
+		for _, identifier := range receiver.identifiers {
+			var strs []string
+			for _, index := range receiver.mentions[identifier] {
+				strs = append(strs, receiver.quads[index].format(func(other Identifier) string {
+					if other == identifier {
+						return "_:self"
+					}
+					return "_:" + colors[other]
+				}))
+			}
+			slices.Sort(strs)
+
+			next[identifier] = colorHash(append([]string{colors[identifier]}, strs...)...)
+		}
+
+		if countColors(next) == countColors(colors) {
+			return colors
+		}
+		colors = next
+	}
+}
+
+func (receiver canonicalizer) search(colors map[Identifier]string) canonicalization {
+	colors = receiver.refine(colors)
+
+	var class []Identifier
+	{
+		var classes map[string][]Identifier = map[string][]Identifier{}
+		for _, identifier := range receiver.identifiers {
+			var color string = colors[identifier]
+			classes[color] = append(classes[color], identifier)
+		}
+
+		var classColor string
+		for color, members := range classes {
+			if len(members) < 2 {
+				continue
+			}
+			if nil == class || len(members) < len(class) || (len(members) == len(class) && color < classColor) {
+				class = members
+				classColor = color
+			}
+		}
+	}
+
+	if nil == class {
+		var lines []string = make([]string, 0, len(receiver.quads))
+		for _, quad := range receiver.quads {
+			lines = append(lines, quad.format(func(identifier Identifier) string {
+				return "_:" + colors[identifier]
+			}))
+		}
+		slices.Sort(lines)
+
+		return canonicalization{
+			labels: colors,
+			lines:  lines,
+		}
+	}
+
+	var best canonicalization
+	var bestString string
+	for _, member := range class {
+		var individualized map[Identifier]string = maps.Clone(colors)
+		individualized[member] = colorHash(individualized[member], "*")
+
+		var result canonicalization = receiver.search(individualized)
+		var resultString string = strings.Join(result.lines, "\n")
+
+		if nil == best.labels || resultString < bestString {
+			best = result
+			bestString = resultString
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,261 @@
+package blanknode
+
+import (
+	"slices"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+var (
+	rdfSubjectTerm   = GroundTerm("<http://www.w3.org/1999/02/22-rdf-syntax-ns#subject>")
+	rdfPredicateTerm = GroundTerm("<http://www.w3.org/1999/02/22-rdf-syntax-ns#predicate>")
+	rdfObjectTerm    = GroundTerm("<http://www.w3.org/1999/02/22-rdf-syntax-ns#object>")
+)
+
+// CBDOptions are the options for [CBD].
+//
+// The zero value of CBDOptions gives the (plain) Concise Bounded Description, with no budget.
+type CBDOptions struct {
+	// Symmetric, if true, gives the Symmetric Concise Bounded Description.
+	// I.e., also include the quads that have the resource as the object, recursively expanded (backwards) through subject blank-nodes.
+	Symmetric bool
+
+	// Reified, if true, also includes the Concise Bounded Description of each reification of each included quad.
+	// (A reification is a resource with rdf:subject, rdf:predicate, and rdf:object that match the quad.)
+	Reified bool
+
+	// MaxDepth, if greater than zero, is the maximum number of blank-nodes to follow away from the resource.
+	MaxDepth int
+
+	// MaxQuads, if greater than zero, is the maximum number of quads to return.
+	MaxQuads int
+}
+
+// CBD returns the Concise Bounded Description (CBD) of the resource ‘subject’ from ‘quads’.
+//
+// The CBD is:
+//
+// • all the quads with ‘subject’ as the subject, plus
+//
+// • recursively, for each of those quads whose object is a blank-node, all the quads with that blank-node as the subject.
+//
+// (From: Stickler, "CBD — Concise Bounded Description", W3C Member Submission, 2005.)
+//
+// Quads in any graph are included.
+//
+// Each blank-node is only expanded once, so cycles of blank-nodes are OK.
+// Blank-nodes are followed breadth-first, so (with ‘MaxDepth’) each is expanded at the smallest depth it can be reached at —
+// and (without ‘MaxQuads’) the result does not depend on the order of ‘quads’.
+//
+// The quads are returned sorted by their N-Quads statement, with duplicates removed.
+//
+// If ‘options’ has a budget (‘MaxDepth’ or ‘MaxQuads’) and it was exceeded, then the quads found within the budget are returned together with an error that wraps [ErrBudgetExceeded].
+func CBD(quads []Quad, subject Term, options CBDOptions) ([]Quad, error) {
+	if subject.IsNothing() {
+		return nil, ErrEmptyTerm
+	}
+
+	var index cbdIndex = newCBDIndex(quads)
+
+	var c cbd = cbd{
+		index:    index,
+		options:  options,
+		included: map[Quad]struct{}{},
+		forward:  map[Term]int{},
+		backward: map[Term]int{},
+	}
+
+	c.walk(subject)
+
+	var result []Quad = make([]Quad, 0, len(c.included))
+	for quad := range c.included {
+		result = append(result, quad)
+	}
+	slices.SortFunc(result, compareQuads)
+
+	if c.exceeded {
+		return result, erorr.Errorf("concise-bounded-description of %s: %w", subject, ErrBudgetExceeded)
+	}
+
+	return result, nil
+}
+
+type cbdIndex struct {
+	bySubject map[Term][]Quad
+	byObject  map[Term][]Quad
+}
+
+func newCBDIndex(quads []Quad) cbdIndex {
+	var index cbdIndex = cbdIndex{
+		bySubject: map[Term][]Quad{},
+		byObject:  map[Term][]Quad{},
+	}
+
+	for _, quad := range quads {
+		index.bySubject[quad.Subject] = append(index.bySubject[quad.Subject], quad)
+		index.byObject[quad.Object] = append(index.byObject[quad.Object], quad)
+	}
+
+	return index
+}
+
+type cbd struct {
+	index    cbdIndex
+	options  CBDOptions
+	included map[Quad]struct{}
+	exceeded bool
+
+	// forward and backward are the (smallest) depth each term has been described at, in each direction.
+	forward  map[Term]int
+	backward map[Term]int
+
+	// levels are the terms still to describe, by depth.
+	levels [][]cbdStep
+
+	// beyond are the blank-nodes that were not followed because they are past ‘MaxDepth’.
+	beyond []cbdStep
+}
+
+// cbdStep is describing ‘term’ (forward, or backward) at ‘depth’.
+type cbdStep struct {
+	term     Term
+	depth    int
+	backward bool
+}
+
+// include includes ‘quad’, and returns whether it is included.
+// (It is not included if that would exceed ‘MaxQuads’.)
+func (receiver *cbd) include(quad Quad) bool {
+	if _, found := receiver.included[quad]; found {
+		return true
+	}
+	if 0 < receiver.options.MaxQuads && receiver.options.MaxQuads <= len(receiver.included) {
+		receiver.exceeded = true
+		return false
+	}
+
+	receiver.included[quad] = struct{}{}
+	return true
+}
+
+// walk describes ‘subject’, and everything reachable from it, breadth-first —
+// so that each blank-node is described at the smallest depth it can be reached at, no matter what order the quads are in.
+func (receiver *cbd) walk(subject Term) {
+	receiver.describe(subject, 0)
+
+	for depth := 0; depth < len(receiver.levels); depth++ {
+		// (Reifications are described at the same depth, so this level can grow while it is being walked.)
+		for index := 0; index < len(receiver.levels[depth]); index++ {
+			var step cbdStep = receiver.levels[depth][index]
+
+			if step.backward {
+				if step.depth == receiver.backward[step.term] {
+					receiver.describeBackward(step.term, step.depth)
+				}
+			} else {
+				if step.depth == receiver.forward[step.term] {
+					receiver.describeForward(step.term, step.depth)
+				}
+			}
+		}
+	}
+
+	// A blank-node past ‘MaxDepth’ only exceeds the budget if it was not (also) reached within it.
+	for _, step := range receiver.beyond {
+		var depths map[Term]int = receiver.forward
+		if step.backward {
+			depths = receiver.backward
+		}
+		if _, found := depths[step.term]; !found {
+			receiver.exceeded = true
+			break
+		}
+	}
+}
+
+func (receiver *cbd) describe(term Term, depth int) {
+	receiver.schedule(cbdStep{term: term, depth: depth})
+	if receiver.options.Symmetric {
+		receiver.schedule(cbdStep{term: term, depth: depth, backward: true})
+	}
+}
+
+// schedule has ‘step’ walked — unless its term has already been described (in the same direction) at the same or a smaller depth.
+func (receiver *cbd) schedule(step cbdStep) {
+	if 0 < receiver.options.MaxDepth && receiver.options.MaxDepth < step.depth {
+		receiver.beyond = append(receiver.beyond, step)
+		return
+	}
+
+	var depths map[Term]int = receiver.forward
+	if step.backward {
+		depths = receiver.backward
+	}
+	if depth, found := depths[step.term]; found && depth <= step.depth {
+		return
+	}
+	depths[step.term] = step.depth
+
+	for len(receiver.levels) <= step.depth {
+		receiver.levels = append(receiver.levels, nil)
+	}
+	receiver.levels[step.depth] = append(receiver.levels[step.depth], step)
+}
+
+// describeForward includes the quads with ‘term’ as the subject, and follows object blank-nodes.
+func (receiver *cbd) describeForward(term Term, depth int) {
+	for _, quad := range receiver.index.bySubject[term] {
+		if !receiver.include(quad) {
+			continue
+		}
+
+		if quad.Object.IsBlankNode() {
+			receiver.schedule(cbdStep{term: quad.Object, depth: depth + 1})
+		}
+		receiver.reifications(quad, depth)
+	}
+}
+
+// describeBackward includes the quads with ‘term’ as the object, and follows subject blank-nodes.
+func (receiver *cbd) describeBackward(term Term, depth int) {
+	for _, quad := range receiver.index.byObject[term] {
+		if !receiver.include(quad) {
+			continue
+		}
+
+		if quad.Subject.IsBlankNode() {
+			receiver.schedule(cbdStep{term: quad.Subject, depth: depth + 1, backward: true})
+		}
+		receiver.reifications(quad, depth)
+	}
+}
+
+// reifications includes the CBD of each reification of ‘quad’.
+func (receiver *cbd) reifications(quad Quad, depth int) {
+	if !receiver.options.Reified {
+		return
+	}
+
+	for _, candidate := range receiver.index.byObject[quad.Subject] {
+		if rdfSubjectTerm != candidate.Predicate {
+			continue
+		}
+
+		var reification Term = candidate.Subject
+		if !receiver.has(reification, rdfPredicateTerm, quad.Predicate) || !receiver.has(reification, rdfObjectTerm, quad.Object) {
+			continue
+		}
+
+		receiver.describe(reification, depth)
+	}
+}
+
+func (receiver *cbd) has(subject Term, predicate Term, object Term) bool {
+	for _, quad := range receiver.index.bySubject[subject] {
+		if predicate == quad.Predicate && object == quad.Object {
+			return true
+		}
+	}
+
+	return false
+}
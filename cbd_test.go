@@ -0,0 +1,238 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"slices"
+)
+
+func cbdTestQuads() []Quad {
+	return []Quad{
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("a")),
+		msgTestQuad(msgTestBlankNode("a"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+		msgTestQuad(msgTestBlankNode("a"), msgTestIRI("geo"), msgTestBlankNode("g")),
+		msgTestQuad(msgTestBlankNode("g"), msgTestIRI("lat"), GroundTerm(`"49.28"`)),
+		msgTestQuad(msgTestBlankNode("g"), msgTestIRI("back"), msgTestBlankNode("a")),
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("knows"), msgTestIRI("jane")),
+		msgTestQuad(msgTestIRI("jane"), msgTestIRI("name"), GroundTerm(`"Jane"`)),
+		msgTestQuad(msgTestBlankNode("f"), msgTestIRI("follows"), msgTestIRI("joe")),
+		msgTestQuad(msgTestBlankNode("f"), msgTestIRI("name"), GroundTerm(`"Fan"`)),
+		msgTestQuad(msgTestBlankNode("r"), GroundTerm("<http://www.w3.org/1999/02/22-rdf-syntax-ns#subject>"), msgTestIRI("joe")),
+		msgTestQuad(msgTestBlankNode("r"), GroundTerm("<http://www.w3.org/1999/02/22-rdf-syntax-ns#predicate>"), msgTestIRI("knows")),
+		msgTestQuad(msgTestBlankNode("r"), GroundTerm("<http://www.w3.org/1999/02/22-rdf-syntax-ns#object>"), msgTestIRI("jane")),
+		msgTestQuad(msgTestBlankNode("r"), msgTestIRI("source"), msgTestIRI("gossip")),
+	}
+}
+
+func TestCBD(t *testing.T) {
+	tests := []struct {
+		Options       CBDOptions
+		Expected      []string
+		ExpectedError error
+	}{
+		{
+			Expected: []string{
+				`<http://example.com/joe> <http://example.com/address> _:a .`,
+				`<http://example.com/joe> <http://example.com/knows> <http://example.com/jane> .`,
+				`<http://example.com/joe> <http://example.com/name> "Joe" .`,
+				`_:a <http://example.com/city> "Vancouver" .`,
+				`_:a <http://example.com/geo> _:g .`,
+				`_:g <http://example.com/back> _:a .`,
+				`_:g <http://example.com/lat> "49.28" .`,
+			},
+		},
+		{
+			Options: CBDOptions{
+				Symmetric: true,
+			},
+			Expected: []string{
+				`<http://example.com/joe> <http://example.com/address> _:a .`,
+				`<http://example.com/joe> <http://example.com/knows> <http://example.com/jane> .`,
+				`<http://example.com/joe> <http://example.com/name> "Joe" .`,
+				`_:a <http://example.com/city> "Vancouver" .`,
+				`_:a <http://example.com/geo> _:g .`,
+				`_:f <http://example.com/follows> <http://example.com/joe> .`,
+				`_:g <http://example.com/back> _:a .`,
+				`_:g <http://example.com/lat> "49.28" .`,
+				`_:r <http://www.w3.org/1999/02/22-rdf-syntax-ns#subject> <http://example.com/joe> .`,
+			},
+		},
+		{
+			Options: CBDOptions{
+				Reified: true,
+			},
+			Expected: []string{
+				`<http://example.com/joe> <http://example.com/address> _:a .`,
+				`<http://example.com/joe> <http://example.com/knows> <http://example.com/jane> .`,
+				`<http://example.com/joe> <http://example.com/name> "Joe" .`,
+				`_:a <http://example.com/city> "Vancouver" .`,
+				`_:a <http://example.com/geo> _:g .`,
+				`_:g <http://example.com/back> _:a .`,
+				`_:g <http://example.com/lat> "49.28" .`,
+				`_:r <http://example.com/source> <http://example.com/gossip> .`,
+				`_:r <http://www.w3.org/1999/02/22-rdf-syntax-ns#object> <http://example.com/jane> .`,
+				`_:r <http://www.w3.org/1999/02/22-rdf-syntax-ns#predicate> <http://example.com/knows> .`,
+				`_:r <http://www.w3.org/1999/02/22-rdf-syntax-ns#subject> <http://example.com/joe> .`,
+			},
+		},
+		{
+			Options: CBDOptions{
+				MaxDepth: 1,
+			},
+			Expected: []string{
+				`<http://example.com/joe> <http://example.com/address> _:a .`,
+				`<http://example.com/joe> <http://example.com/knows> <http://example.com/jane> .`,
+				`<http://example.com/joe> <http://example.com/name> "Joe" .`,
+				`_:a <http://example.com/city> "Vancouver" .`,
+				`_:a <http://example.com/geo> _:g .`,
+			},
+			ExpectedError: ErrBudgetExceeded,
+		},
+		{
+			Options: CBDOptions{
+				MaxQuads: 2,
+			},
+			Expected: []string{
+				`<http://example.com/joe> <http://example.com/address> _:a .`,
+				`<http://example.com/joe> <http://example.com/name> "Joe" .`,
+			},
+			ExpectedError: ErrBudgetExceeded,
+		},
+	}
+
+	for testNumber, test := range tests {
+		quads, err := CBD(cbdTestQuads(), msgTestIRI("joe"), test.Options)
+		if nil == test.ExpectedError && nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one.", testNumber)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+		if nil != test.ExpectedError && !errors.Is(err, test.ExpectedError) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED-ERROR: %s", test.ExpectedError)
+			t.Logf("ACTUAL-ERROR:   %s", err)
+			continue
+		}
+
+		var actual []string
+		for _, quad := range quads {
+			actual = append(actual, quad.String())
+		}
+
+		if expected := test.Expected; !slices.Equal(expected, actual) {
+			t.Errorf("For test #%d, the actual concise-bounded-description is not what was expected.", testNumber)
+			for _, str := range expected {
+				t.Logf("EXPECTED: %s", str)
+			}
+			for _, str := range actual {
+				t.Logf("ACTUAL:   %s", str)
+			}
+			continue
+		}
+	}
+}
+
+// TestCBD_maxDepthOrder checks that, with ‘MaxDepth’, a blank-node that can be reached at two different depths is described at the smaller one —
+// whatever the order of the quads.
+func TestCBD_maxDepthOrder(t *testing.T) {
+	var s Term = msgTestIRI("s")
+
+	// _:b can be reached at depth 2 (through _:a) and at depth 1 (directly).
+	var long []Quad = []Quad{
+		msgTestQuad(s, msgTestIRI("p"), msgTestBlankNode("a")),
+		msgTestQuad(msgTestBlankNode("a"), msgTestIRI("p"), msgTestBlankNode("b")),
+		msgTestQuad(msgTestBlankNode("b"), msgTestIRI("p"), msgTestBlankNode("c")),
+		msgTestQuad(msgTestBlankNode("c"), msgTestIRI("p"), msgTestIRI("o")),
+	}
+	var short Quad = msgTestQuad(s, msgTestIRI("p2"), msgTestBlankNode("b"))
+
+	tests := []struct {
+		Name          string
+		Quads         []Quad
+		Options       CBDOptions
+		Expected      []string
+		ExpectedError error
+	}{
+		{
+			Name:    "longer path first",
+			Quads:   append(slices.Clone(long), short),
+			Options: CBDOptions{MaxDepth: 2},
+			Expected: []string{
+				`<http://example.com/s> <http://example.com/p2> _:b .`,
+				`<http://example.com/s> <http://example.com/p> _:a .`,
+				`_:a <http://example.com/p> _:b .`,
+				`_:b <http://example.com/p> _:c .`,
+				`_:c <http://example.com/p> <http://example.com/o> .`,
+			},
+		},
+		{
+			Name:    "shorter path first",
+			Quads:   append([]Quad{short}, long...),
+			Options: CBDOptions{MaxDepth: 2},
+			Expected: []string{
+				`<http://example.com/s> <http://example.com/p2> _:b .`,
+				`<http://example.com/s> <http://example.com/p> _:a .`,
+				`_:a <http://example.com/p> _:b .`,
+				`_:b <http://example.com/p> _:c .`,
+				`_:c <http://example.com/p> <http://example.com/o> .`,
+			},
+		},
+		{
+			Name:    "longer path first, MaxDepth 1",
+			Quads:   append(slices.Clone(long), short),
+			Options: CBDOptions{MaxDepth: 1},
+			Expected: []string{
+				`<http://example.com/s> <http://example.com/p2> _:b .`,
+				`<http://example.com/s> <http://example.com/p> _:a .`,
+				`_:a <http://example.com/p> _:b .`,
+				`_:b <http://example.com/p> _:c .`,
+			},
+			ExpectedError: ErrBudgetExceeded,
+		},
+		{
+			Name:    "shorter path first, MaxDepth 1",
+			Quads:   append([]Quad{short}, long...),
+			Options: CBDOptions{MaxDepth: 1},
+			Expected: []string{
+				`<http://example.com/s> <http://example.com/p2> _:b .`,
+				`<http://example.com/s> <http://example.com/p> _:a .`,
+				`_:a <http://example.com/p> _:b .`,
+				`_:b <http://example.com/p> _:c .`,
+			},
+			ExpectedError: ErrBudgetExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		quads, err := CBD(test.Quads, s, test.Options)
+		if nil == test.ExpectedError && nil != err {
+			t.Errorf("For %s, did not expect an error, but actually got one.", test.Name)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+		if nil != test.ExpectedError && !errors.Is(err, test.ExpectedError) {
+			t.Errorf("For %s, the actual error is not what was expected.", test.Name)
+			t.Logf("EXPECTED-ERROR: %s", test.ExpectedError)
+			t.Logf("ACTUAL-ERROR:   %v", err)
+			continue
+		}
+
+		var actual []string
+		for _, quad := range quads {
+			actual = append(actual, quad.String())
+		}
+
+		if expected := test.Expected; !slices.Equal(expected, actual) {
+			t.Errorf("For %s, the actual concise-bounded-description is not what was expected.", test.Name)
+			for _, str := range expected {
+				t.Logf("EXPECTED: %s", str)
+			}
+			for _, str := range actual {
+				t.Logf("ACTUAL:   %s", str)
+			}
+			continue
+		}
+	}
+}
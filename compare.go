@@ -0,0 +1,192 @@
+package blanknode
+
+import (
+	"slices"
+	"strings"
+)
+
+// Compare returns an integer comparing two blank-node-identifiers.
+// The result is 0 if a == b, -1 if a < b, and +1 if a > b.
+//
+// Blank-node-identifiers are ordered lexicographically by Unicode code point, which is the order that RDF Dataset Canonicalization (RDFC-1.0) requires.
+// For example:
+//
+//	_:b0
+//	_:b1
+//	_:b10
+//	_:b2
+//
+// [NoIdentifier] comes before all other blank-node-identifiers.
+//
+// See also: [CompareNatural], [SortIdentifiers].
+func Compare(a Identifier, b Identifier) int {
+	return CompareLabels(a.label, b.label)
+}
+
+// CompareLabels returns an integer comparing two blank-node-labels.
+// The result is 0 if a == b, -1 if a < b, and +1 if a > b.
+//
+// Blank-node-labels are ordered lexicographically by Unicode code point.
+//
+// [NoLabel] comes before all other blank-node-labels.
+//
+// See also: [CompareLabelsNatural], [SortLabels].
+func CompareLabels(a Label, b Label) int {
+	aValue, aFound := a.Get()
+	bValue, bFound := b.Get()
+
+	switch {
+	case !aFound && !bFound:
+		return 0
+	case !aFound:
+		return -1
+	case !bFound:
+		return 1
+	}
+
+	// Comparing UTF-8 encoded strings byte-by-byte gives the same result as comparing them code-point-by-code-point.
+	return strings.Compare(aValue, bValue)
+}
+
+// CompareNatural returns an integer comparing two blank-node-identifiers, in a way that is more natural for humans.
+// The result is 0 if a == b, -1 if a < b, and +1 if a > b.
+//
+// It is like [Compare] except that runs of digits are compared by their numeric value.
+// For example:
+//
+//	_:b0
+//	_:b1
+//	_:b2
+//	_:b10
+//
+// If two runs of digits have the same numeric value, then the one with fewer leading zeros comes first.
+//
+// [NoIdentifier] comes before all other blank-node-identifiers.
+//
+// See also: [Compare], [SortIdentifiersNatural].
+func CompareNatural(a Identifier, b Identifier) int {
+	return CompareLabelsNatural(a.label, b.label)
+}
+
+// CompareLabelsNatural returns an integer comparing two blank-node-labels, in a way that is more natural for humans.
+// The result is 0 if a == b, -1 if a < b, and +1 if a > b.
+//
+// It is like [CompareLabels] except that runs of digits are compared by their numeric value.
+//
+// [NoLabel] comes before all other blank-node-labels.
+//
+// See also: [CompareNatural], [SortLabelsNatural].
+func CompareLabelsNatural(a Label, b Label) int {
+	aValue, aFound := a.Get()
+	bValue, bFound := b.Get()
+
+	switch {
+	case !aFound && !bFound:
+		return 0
+	case !aFound:
+		return -1
+	case !bFound:
+		return 1
+	}
+
+	if result := compareNatural(aValue, bValue); 0 != result {
+		return result
+	}
+
+	return strings.Compare(aValue, bValue)
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+func compareNatural(a string, b string) int {
+	for "" != a && "" != b {
+		if !isDigit(a[0]) || !isDigit(b[0]) {
+			// Digits are ASCII, so it is OK to compare the rest of the string up to the next digit byte-by-byte.
+			var aIndex int = strings.IndexFunc(a, isDigitRune)
+			if aIndex < 0 {
+				aIndex = len(a)
+			}
+			var bIndex int = strings.IndexFunc(b, isDigitRune)
+			if bIndex < 0 {
+				bIndex = len(b)
+			}
+
+			if result := strings.Compare(a[:aIndex], b[:bIndex]); 0 != result {
+				return result
+			}
+
+			a = a[aIndex:]
+			b = b[bIndex:]
+			continue
+		}
+
+		var aDigits string = a[:digitsLength(a)]
+		var bDigits string = b[:digitsLength(b)]
+		a = a[len(aDigits):]
+		b = b[len(bDigits):]
+
+		var aTrimmed string = strings.TrimLeft(aDigits, "0")
+		var bTrimmed string = strings.TrimLeft(bDigits, "0")
+
+		switch {
+		case len(aTrimmed) < len(bTrimmed):
+			return -1
+		case len(aTrimmed) > len(bTrimmed):
+			return 1
+		}
+		if result := strings.Compare(aTrimmed, bTrimmed); 0 != result {
+			return result
+		}
+
+		switch {
+		case len(aDigits) < len(bDigits):
+			return -1
+		case len(aDigits) > len(bDigits):
+			return 1
+		}
+	}
+
+	switch {
+	case "" == a && "" == b:
+		return 0
+	case "" == a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func isDigitRune(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+func digitsLength(value string) int {
+	var length int
+	for length < len(value) && isDigit(value[length]) {
+		length++
+	}
+
+	return length
+}
+
+// SortIdentifiers sorts a slice of blank-node-identifiers in the order of [Compare].
+func SortIdentifiers(identifiers []Identifier) {
+	slices.SortFunc(identifiers, Compare)
+}
+
+// SortIdentifiersNatural sorts a slice of blank-node-identifiers in the order of [CompareNatural].
+func SortIdentifiersNatural(identifiers []Identifier) {
+	slices.SortFunc(identifiers, CompareNatural)
+}
+
+// SortLabels sorts a slice of blank-node-labels in the order of [CompareLabels].
+func SortLabels(labels []Label) {
+	slices.SortFunc(labels, CompareLabels)
+}
+
+// SortLabelsNatural sorts a slice of blank-node-labels in the order of [CompareLabelsNatural].
+func SortLabelsNatural(labels []Label) {
+	slices.SortFunc(labels, CompareLabelsNatural)
+}
@@ -0,0 +1,165 @@
+package blanknode
+
+import (
+	"testing"
+
+	"math/rand"
+	"slices"
+)
+
+func compareTestIdentifiers(labels ...string) []Identifier {
+	var identifiers []Identifier = []Identifier{NoIdentifier()}
+	for _, label := range labels {
+		identifiers = append(identifiers, someIdentifier(someLabel(label)))
+	}
+
+	return identifiers
+}
+
+func TestSortIdentifiers(t *testing.T) {
+	expected := compareTestIdentifiers(
+		"B0",
+		"_b",
+		"b",
+		"b0",
+		"b00",
+		"b01",
+		"b1",
+		"b10",
+		"b2",
+		"b9.x",
+		"c14n0",
+		"c14n1",
+		"c14n10",
+		"c14n11",
+		"c14n2",
+		"n1",
+		"é",
+		"ω",
+		"\U00010000",
+	)
+
+	for i := 0; i < 16; i++ {
+		actual := slices.Clone(expected)
+		rand.Shuffle(len(actual), func(i, j int) {
+			actual[i], actual[j] = actual[j], actual[i]
+		})
+
+		SortIdentifiers(actual)
+
+		if !slices.Equal(expected, actual) {
+			t.Errorf("For shuffle #%d, the actual sorted blank-node-identifiers are not what was expected.", i)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+	}
+}
+
+func TestSortIdentifiersNatural(t *testing.T) {
+	expected := compareTestIdentifiers(
+		"0",
+		"1",
+		"01",
+		"2",
+		"10",
+		"B0",
+		"_b",
+		"b",
+		"b0",
+		"b00",
+		"b1",
+		"b01",
+		"b2",
+		"b9.x",
+		"b10",
+		"b10a",
+		"b10a2",
+		"b10a10",
+		"b18446744073709551616",
+		"b99999999999999999999",
+		"b100000000000000000000",
+		"c14n0",
+		"c14n1",
+		"c14n2",
+		"c14n10",
+		"c14n11",
+		"n1",
+		"é",
+		"ω",
+		"\U00010000",
+	)
+
+	for i := 0; i < 16; i++ {
+		actual := slices.Clone(expected)
+		rand.Shuffle(len(actual), func(i, j int) {
+			actual[i], actual[j] = actual[j], actual[i]
+		})
+
+		SortIdentifiersNatural(actual)
+
+		if !slices.Equal(expected, actual) {
+			t.Errorf("For shuffle #%d, the actual sorted blank-node-identifiers are not what was expected.", i)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+	}
+}
+
+func TestCompareNatural(t *testing.T) {
+	tests := []struct {
+		A        Identifier
+		B        Identifier
+		Expected int
+	}{
+		{
+			A:        NoIdentifier(),
+			B:        NoIdentifier(),
+			Expected: 0,
+		},
+		{
+			A:        NoIdentifier(),
+			B:        someIdentifier(someLabel("b0")),
+			Expected: -1,
+		},
+		{
+			A:        someIdentifier(someLabel("b0")),
+			B:        NoIdentifier(),
+			Expected: 1,
+		},
+		{
+			A:        someIdentifier(someLabel("b10")),
+			B:        someIdentifier(someLabel("b10")),
+			Expected: 0,
+		},
+		{
+			A:        someIdentifier(someLabel("b2")),
+			B:        someIdentifier(someLabel("b10")),
+			Expected: -1,
+		},
+		{
+			A:        someIdentifier(someLabel("b10")),
+			B:        someIdentifier(someLabel("b2")),
+			Expected: 1,
+		},
+		{
+			A:        someIdentifier(someLabel("b2")),
+			B:        someIdentifier(someLabel("b02")),
+			Expected: -1,
+		},
+	}
+
+	for testNumber, test := range tests {
+		actual := CompareNatural(test.A, test.B)
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the actual comparison is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("A: %q", test.A)
+			t.Logf("B: %q", test.B)
+			continue
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package blanknode
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:generate go run gen_confusables.go
+//go:generate go run gen_scriptextensions.go
+
+// confusablePrototype returns the "prototype" of ‘r’ (in NFD), from the Unicode confusables data — see confusablePrototypes.
+func confusablePrototype(r rune) string {
+	if prototype, found := confusablePrototypes[r]; found {
+		return prototype
+	}
+
+	return string(r)
+}
+
+// Skeleton returns the "skeleton" of the blank-node-label, as defined by Unicode Technical Standard #39 (https://www.unicode.org/reports/tr39/#Confusable_Detection).
+//
+// Two blank-node-labels that look the same have the same skeleton — even if they are different.
+// For example, these have the same skeleton:
+//
+//	admin
+//	аdmin
+//
+// (The second begins with U+0430 CYRILLIC SMALL LETTER A.)
+//
+// A skeleton is only for comparing.
+// It is not necessarily a valid blank-node-label, and should not be shown to users.
+//
+// This uses the Unicode confusables data, version 15.0.0 (see testdata/unicode).
+// Code points added to Unicode after that have no confusables.
+//
+// See also: [Confusable].
+func (receiver Label) Skeleton() string {
+	value, found := receiver.optional.Get()
+	if !found {
+		return ""
+	}
+
+	var builder strings.Builder
+	for _, r := range norm.NFD.String(value) {
+		builder.WriteString(confusablePrototype(r))
+	}
+
+	return norm.NFD.String(builder.String())
+}
+
+// Confusable returns whether the blank-node-labels ‘a’ and ‘b’ are confusable — i.e., whether they (might) look the same.
+//
+// Note that a blank-node-label is confusable with itself.
+//
+// See also: [Label.Skeleton].
+func Confusable(a Label, b Label) bool {
+	if a.IsNothing() || b.IsNothing() {
+		return a.IsNothing() && b.IsNothing()
+	}
+
+	return a.Skeleton() == b.Skeleton()
+}
+
+// MixedScript returns whether the blank-node-label mixes characters from different Unicode scripts, as defined by Unicode Technical Standard #39 (https://www.unicode.org/reports/tr39/#Mixed_Script_Detection).
+//
+// I.e., it returns whether the "resolved script set" of the blank-node-label is empty —
+// using the (augmented) Script_Extensions property of each character.
+//
+// So, characters in the Common and Inherited scripts (such as digits, "_", "-", ".", and combining marks) go with any script.
+// A character with more than one script (such as U+0660 ARABIC-INDIC DIGIT ZERO, which is Arabic, Thaana, and Yezidi) goes with any of them.
+// And Han goes with Hiragana and Katakana (Japanese), Hangul (Korean), and Bopomofo.
+//
+// For example, this is not mixed-script:
+//
+//	admin_01
+//
+// But this is (since it begins with U+0430 CYRILLIC SMALL LETTER A):
+//
+//	аdmin_01
+//
+// This uses the Unicode Script_Extensions data, version 15.0.0 (see testdata/unicode).
+// Code points added to Unicode after that are in the Unknown script.
+func (receiver Label) MixedScript() bool {
+	value, found := receiver.optional.Get()
+	if !found {
+		return false
+	}
+
+	var resolved scriptSet = scriptSets[0]
+
+	for _, r := range value {
+		resolved.intersect(labelScriptSet(r))
+		if resolved.empty() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scriptSet is a set of scripts, with a bit for each of scriptNames.
+type scriptSet [scriptSetWords]uint64
+
+func (receiver *scriptSet) intersect(other *scriptSet) {
+	for index := range receiver {
+		receiver[index] &= other[index]
+	}
+}
+
+func (receiver *scriptSet) empty() bool {
+	for _, word := range receiver {
+		if 0 != word {
+			return false
+		}
+	}
+
+	return true
+}
+
+// scriptExtensionRange is a range of code points (from ‘low’ to ‘high’, inclusive) with the same (augmented) Script_Extensions — scriptSets[set].
+type scriptExtensionRange struct {
+	low  rune
+	high rune
+	set  uint16
+}
+
+var scriptSetUnknown scriptSet = func() scriptSet {
+	var set scriptSet
+	set[scriptUnknown/64] = 1 << (scriptUnknown % 64)
+	return set
+}()
+
+// labelScriptSet returns the (augmented) Script_Extensions of ‘r’, as in UTS #39.
+func labelScriptSet(r rune) *scriptSet {
+	var index int = sort.Search(len(scriptExtensionRanges), func(i int) bool {
+		return r <= scriptExtensionRanges[i].high
+	})
+	if len(scriptExtensionRanges) <= index || r < scriptExtensionRanges[index].low {
+		return &scriptSetUnknown
+	}
+
+	return &scriptSets[scriptExtensionRanges[index].set]
+}
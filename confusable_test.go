@@ -0,0 +1,435 @@
+package blanknode
+
+import (
+	"testing"
+
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestLabel_Skeleton(t *testing.T) {
+	tests := []struct {
+		Label    Label
+		Expected string
+	}{
+		{
+			Label:    NoLabel(),
+			Expected: "",
+		},
+
+
+
+		{
+			Label:    someLabel("b0"),
+			Expected: "bO",
+		},
+		{
+			Label:    someLabel("admin"),
+			Expected: "adrnin",
+		},
+		{
+			// U+0430 CYRILLIC SMALL LETTER A
+			Label:    someLabel("\u0430dmin"),
+			Expected: "adrnin",
+		},
+		{
+			Label:    someLabel("adrnin"),
+			Expected: "adrnin",
+		},
+		{
+			// U+0585 ARMENIAN SMALL LETTER OH
+			Label:    someLabel("\u0585dmin"),
+			Expected: "odrnin",
+		},
+		{
+			// U+0399 GREEK CAPITAL LETTER IOTA, U+0406 CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I
+			Label:    someLabel("l1I\u0399\u0406"),
+			Expected: "lllll",
+		},
+		{
+			// U+03BF GREEK SMALL LETTER OMICRON, U+043E CYRILLIC SMALL LETTER O
+			Label:    someLabel("o\u03BF\u043E"),
+			Expected: "ooo",
+		},
+		{
+			// U+FF41 FULLWIDTH LATIN SMALL LETTER A
+			Label:    someLabel("\uFF41b"),
+			Expected: "ab",
+		},
+		{
+			// U+1D41A MATHEMATICAL BOLD SMALL A
+			Label:    someLabel("\U0001D41Ab"),
+			Expected: "ab",
+		},
+		{
+			// U+00E9 LATIN SMALL LETTER E WITH ACUTE, and "e" followed by U+0301 COMBINING ACUTE ACCENT
+			Label:    someLabel("\u00E9"),
+			Expected: "e\u0301",
+		},
+		{
+			Label:    someLabel("e\u0301"),
+			Expected: "e\u0301",
+		},
+		{
+			// U+0435 CYRILLIC SMALL LETTER IE followed by U+0301 COMBINING ACUTE ACCENT
+			Label:    someLabel("\u0435\u0301"),
+			Expected: "e\u0301",
+		},
+		{
+			Label:    someLabel("日本"),
+			Expected: "日本",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		expected := test.Expected
+		actual := test.Label.Skeleton()
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual skeleton is not what was expected.", testNumber)
+			t.Logf("LABEL: %q", test.Label)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+	}
+}
+
+func TestConfusable(t *testing.T) {
+	tests := []struct {
+		A        Label
+		B        Label
+		Expected bool
+	}{
+		{
+			A:        NoLabel(),
+			B:        NoLabel(),
+			Expected: true,
+		},
+		{
+			A:        NoLabel(),
+			B:        someLabel("O"),
+			Expected: false,
+		},
+		{
+			A:        someLabel("admin"),
+			B:        someLabel("admin"),
+			Expected: true,
+		},
+		{
+			A:        someLabel("admin"),
+			B:        someLabel("\u0430dmin"),
+			Expected: true,
+		},
+		{
+			A:        someLabel("admin"),
+			B:        someLabel("\u0430d\u0440in"),
+			Expected: false,
+		},
+		{
+			// U+0585 ARMENIAN SMALL LETTER OH
+			A:        someLabel("\u0585dmin"),
+			B:        someLabel("odmin"),
+			Expected: true,
+		},
+		{
+			A:        someLabel("b0"),
+			B:        someLabel("bO"),
+			Expected: true,
+		},
+		{
+			A:        someLabel("b0"),
+			B:        someLabel("b1"),
+			Expected: false,
+		},
+		{
+			A:        someLabel("caf\u00E9"),
+			B:        someLabel("cafe\u0301"),
+			Expected: true,
+		},
+		{
+			A:        someLabel("admin"),
+			B:        someLabel("Admin"),
+			Expected: false,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		expected := test.Expected
+		actual := Confusable(test.A, test.B)
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual value is not what was expected.", testNumber)
+			t.Logf("A: %q", test.A)
+			t.Logf("B: %q", test.B)
+			t.Logf("EXPECTED: %t", expected)
+			t.Logf("ACTUAL:   %t", actual)
+			continue
+		}
+	}
+}
+
+func TestLabel_MixedScript(t *testing.T) {
+	tests := []struct {
+		Label    Label
+		Expected bool
+	}{
+		{
+			Label:    NoLabel(),
+			Expected: false,
+		},
+
+
+
+		{
+			Label:    someLabel("admin_01"),
+			Expected: false,
+		},
+		{
+			Label:    someLabel("0_1-2.3"),
+			Expected: false,
+		},
+		{
+			Label:    someLabel("cafe\u0301"),
+			Expected: false,
+		},
+		{
+			// Cyrillic.
+			Label:    someLabel("админ"),
+			Expected: false,
+		},
+		{
+			// Han and Hiragana.
+			Label:    someLabel("日本の"),
+			Expected: false,
+		},
+		{
+			// Han and Hangul.
+			Label:    someLabel("韓한"),
+			Expected: false,
+		},
+
+
+
+		{
+			// Cyrillic and Latin.
+			Label:    someLabel("\u0430dmin"),
+			Expected: true,
+		},
+		{
+			// Latin and Greek.
+			Label:    someLabel("b\u03B1"),
+			Expected: true,
+		},
+		{
+			// Hiragana and Hangul.
+			Label:    someLabel("の한"),
+			Expected: true,
+		},
+		{
+			// U+0660 ARABIC-INDIC DIGIT ZERO is Arabic, Thaana, and Yezidi (by Script_Extensions), and U+0780 THAANA LETTER HAA is Thaana.
+			Label:    someLabel("\u0780\u0660"),
+			Expected: false,
+		},
+		{
+			// U+0660 ARABIC-INDIC DIGIT ZERO is not Latin.
+			Label:    someLabel("a\u0660"),
+			Expected: true,
+		},
+		{
+			// U+0780 THAANA LETTER HAA, U+0628 ARABIC LETTER BEH.
+			Label:    someLabel("\u0660\u0780\u0628"),
+			Expected: true,
+		},
+		{
+			// U+E0000 is unassigned.
+			Label:    someLabel("a\U000E0000"),
+			Expected: true,
+		},
+		{
+			// Latin and Han.
+			Label:    someLabel("a日"),
+			Expected: true,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		expected := test.Expected
+		actual := test.Label.MixedScript()
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual value is not what was expected.", testNumber)
+			t.Logf("LABEL: %q", test.Label)
+			t.Logf("EXPECTED: %t", expected)
+			t.Logf("ACTUAL:   %t", actual)
+			continue
+		}
+	}
+}
+
+func TestConfusablesVersion(t *testing.T) {
+	// The confusables and Script_Extensions data have to be the same version of Unicode.
+	if expected, actual := confusablesVersion, scriptExtensionsVersion; expected != actual {
+		t.Errorf("The actual Unicode version is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+// unicodeTestData calls ‘fn’ with the fields of each line of the file ‘name’ in testdata/unicode.
+//
+// The test is skipped if the file is not there — see testdata/unicode/README.md.
+func unicodeTestData(t *testing.T, name string, fn func(fields []string)) {
+	file, err := os.Open("testdata/unicode/" + name)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("%s is not vendored — see testdata/unicode/README.md.", name)
+	}
+	if nil != err {
+		t.Fatalf("Could not open %s: %s", name, err)
+	}
+	defer file.Close()
+
+	var count int
+	var scanner *bufio.Scanner = bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line string = strings.TrimPrefix(scanner.Text(), "\uFEFF")
+		if index := strings.IndexByte(line, '#'); 0 <= index {
+			line = line[:index]
+		}
+		if "" == strings.TrimSpace(line) {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for index := range fields {
+			fields[index] = strings.TrimSpace(fields[index])
+		}
+
+		count++
+		fn(fields)
+	}
+	if err := scanner.Err(); nil != err {
+		t.Fatalf("Could not read %s: %s", name, err)
+	}
+
+	if count <= 0 {
+		t.Errorf("Expected %s to have data, but it actually did not.", name)
+	}
+}
+
+func unicodeTestCodePoints(t *testing.T, field string) string {
+	var builder strings.Builder
+	for _, hex := range strings.Fields(field) {
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if nil != err {
+			t.Fatalf("Bad code point %q: %s", hex, err)
+		}
+		builder.WriteRune(rune(n))
+	}
+	return builder.String()
+}
+
+func TestLabel_Skeleton_confusablesTxt(t *testing.T) {
+	unicodeTestData(t, "confusables.txt", func(fields []string) {
+		var source string = unicodeTestCodePoints(t, fields[0])
+		var target string = unicodeTestCodePoints(t, fields[1])
+
+		// The skeleton algorithm does NFD first, so it never maps a code point that is not its own NFD.
+		if !norm.NFD.IsNormalString(source) {
+			return
+		}
+
+		// The skeleton of a single code point is (the NFD of) its target.
+		var expected string = norm.NFD.String(target)
+		var actual string = someLabel(source).Skeleton()
+
+		if expected != actual {
+			t.Errorf("For %s, the actual skeleton is not what was expected.", fields[0])
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			return
+		}
+	})
+}
+
+func TestLabelScriptSet_scriptsTxt(t *testing.T) {
+	// Scripts.txt uses the long names of scripts (for example, "Latin"), and ScriptExtensions.txt the short ones (for example, "Latn").
+	var shortNames = map[string]string{}
+	unicodeTestData(t, "PropertyValueAliases.txt", func(fields []string) {
+		if "sc" != fields[0] || len(fields) < 3 {
+			return
+		}
+		for _, alias := range fields[2:] {
+			shortNames[alias] = fields[1]
+		}
+	})
+
+	// The Script_Extensions of a code point is its Script, unless ScriptExtensions.txt says otherwise.
+	var expected = map[rune][]string{}
+	unicodeTestData(t, "Scripts.txt", func(fields []string) {
+		short, found := shortNames[fields[1]]
+		if !found {
+			t.Fatalf("Unknown script %q.", fields[1])
+		}
+		low, high := unicodeTestRange(t, fields[0])
+		for r := low; r <= high; r++ {
+			expected[r] = []string{short}
+		}
+	})
+	unicodeTestData(t, "ScriptExtensions.txt", func(fields []string) {
+		low, high := unicodeTestRange(t, fields[0])
+		for r := low; r <= high; r++ {
+			expected[r] = strings.Fields(fields[1])
+		}
+	})
+
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		var actual *scriptSet = labelScriptSet(r)
+
+		scripts, found := expected[r]
+		if !found {
+			if *actual != scriptSetUnknown {
+				t.Errorf("For %04X, expected Unknown (Zzzz), but actually did not get it.", r)
+			}
+			continue
+		}
+
+		for _, script := range scripts {
+			if "Zyyy" == script || "Zinh" == script {
+				if *actual != scriptSets[0] {
+					t.Errorf("For %04X, expected all scripts (for %s), but actually did not get them.", r, script)
+				}
+				continue
+			}
+
+			var bit int = -1
+			for index, name := range scriptNames {
+				if script == name {
+					bit = index
+				}
+			}
+			if bit < 0 || 0 == actual[bit/64]&(1<<(bit%64)) {
+				t.Errorf("For %04X, expected the script %s, but actually did not get it.", r, script)
+			}
+		}
+	}
+}
+
+func unicodeTestRange(t *testing.T, field string) (rune, rune) {
+	low, high, found := strings.Cut(field, "..")
+	if !found {
+		high = low
+	}
+
+	return []rune(unicodeTestCodePoints(t, low))[0], []rune(unicodeTestCodePoints(t, high))[0]
+}
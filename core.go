@@ -0,0 +1,189 @@
+package blanknode
+
+import (
+	"slices"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// CoreResult is what [Core] returns.
+type CoreResult struct {
+	// Quads are the quads of the core, sorted by their N-Quads statement.
+	Quads []Quad
+
+	// RemovedBlankNodes are the blank-nodes that were removed, in the order of [Compare].
+	RemovedBlankNodes []Identifier
+
+	// RemovedQuads are the quads that were removed, sorted by their N-Quads statement.
+	RemovedQuads []Quad
+}
+
+// Core returns the core of an RDF graph — i.e., it "leans" the RDF graph by removing redundant blank-nodes.
+//
+// For example, in:
+//
+//	<http://example.com/a> <http://example.com/p> _:x .
+//	<http://example.com/a> <http://example.com/p> <http://example.com/b> .
+//
+// The first quad is redundant, since it only says that <http://example.com/a> has some <http://example.com/p>, which the second quad already says.
+// So the core is:
+//
+//	<http://example.com/a> <http://example.com/p> <http://example.com/b> .
+//
+// The core of an RDF graph is the smallest subgraph that the RDF graph can be mapped into, by mapping its blank-nodes to other terms (a blank-node homomorphism).
+// The core is equivalent to (simply entails, and is simply entailed by) the RDF graph.
+// And an RDF graph that is its own core is said to be lean.
+//
+// The core is always a subgraph of the RDF graph, so the blank-nodes in the core have the same blank-node-identifiers as they do in the RDF graph.
+//
+// Computing the core is NP-hard in general.
+// So ‘maxWork’, if greater than zero, is a budget on the number of steps of searching.
+// If the budget is exceeded, then the graph leaned so far (which is still equivalent to the RDF graph, but might not be lean) is returned together with an error that wraps [ErrBudgetExceeded].
+func Core(quads []Quad, maxWork int) (CoreResult, error) {
+	var work *int
+	if 0 < maxWork {
+		work = &maxWork
+	}
+
+	var original []Quad
+	{
+		var seen map[Quad]struct{} = map[Quad]struct{}{}
+		for _, quad := range quads {
+			if _, found := seen[quad]; found {
+				continue
+			}
+			seen[quad] = struct{}{}
+
+			original = append(original, quad)
+		}
+	}
+
+	var current []Quad = slices.Clone(original)
+
+	var err error
+loop:
+	for {
+		var source []Quad
+		var identifiers []Identifier
+		{
+			var seen map[Identifier]struct{} = map[Identifier]struct{}{}
+			for _, quad := range current {
+				if !quad.HasBlankNode() {
+					continue
+				}
+
+				source = append(source, quad)
+				for _, identifier := range quad.BlankNodes() {
+					if _, found := seen[identifier]; found {
+						continue
+					}
+					seen[identifier] = struct{}{}
+
+					identifiers = append(identifiers, identifier)
+				}
+			}
+		}
+		SortIdentifiers(identifiers)
+
+		for _, identifier := range identifiers {
+			// If the graph is not lean, then it can be mapped into a proper subgraph of itself, which is missing at least one of its blank-nodes.
+			// So, try to map the graph into the graph without this blank-node.
+			var target []Quad
+			for _, quad := range current {
+				if !slices.Contains(quad.BlankNodes(), identifier) {
+					target = append(target, quad)
+				}
+			}
+
+			mapping, found, e := newHomomorphism(source, target, work).find()
+			if nil != e {
+				err = erorr.Errorf("core: %w", e)
+				break loop
+			}
+			if !found {
+				continue
+			}
+
+			current = mapQuads(current, mapping)
+			continue loop
+		}
+
+		break
+	}
+
+	var result CoreResult = CoreResult{
+		Quads: current,
+	}
+	slices.SortFunc(result.Quads, compareQuads)
+
+	{
+		var kept map[Quad]struct{} = map[Quad]struct{}{}
+		var keptIdentifiers map[Identifier]struct{} = map[Identifier]struct{}{}
+		for _, quad := range current {
+			kept[quad] = struct{}{}
+			for _, identifier := range quad.BlankNodes() {
+				keptIdentifiers[identifier] = struct{}{}
+			}
+		}
+
+		var removedIdentifiers map[Identifier]struct{} = map[Identifier]struct{}{}
+		for _, quad := range original {
+			if _, found := kept[quad]; !found {
+				result.RemovedQuads = append(result.RemovedQuads, quad)
+			}
+			for _, identifier := range quad.BlankNodes() {
+				if _, found := keptIdentifiers[identifier]; found {
+					continue
+				}
+				if _, found := removedIdentifiers[identifier]; found {
+					continue
+				}
+				removedIdentifiers[identifier] = struct{}{}
+
+				result.RemovedBlankNodes = append(result.RemovedBlankNodes, identifier)
+			}
+		}
+		slices.SortFunc(result.RemovedQuads, compareQuads)
+		SortIdentifiers(result.RemovedBlankNodes)
+	}
+
+	return result, err
+}
+
+// mapQuads returns ‘quads’ with the blank-nodes replaced by ‘mapping’ (with duplicates removed).
+// Blank-nodes not in ‘mapping’ are left as they are.
+func mapQuads(quads []Quad, mapping map[Identifier]Term) []Quad {
+	mapTerm := func(term Term) Term {
+		identifier, found := term.BlankNode()
+		if !found {
+			return term
+		}
+
+		value, found := mapping[identifier]
+		if !found {
+			return term
+		}
+
+		return value
+	}
+
+	var result []Quad
+	var seen map[Quad]struct{} = map[Quad]struct{}{}
+	for _, quad := range quads {
+		var mapped Quad = Quad{
+			Subject:   mapTerm(quad.Subject),
+			Predicate: mapTerm(quad.Predicate),
+			Object:    mapTerm(quad.Object),
+			Graph:     mapTerm(quad.Graph),
+		}
+
+		if _, found := seen[mapped]; found {
+			continue
+		}
+		seen[mapped] = struct{}{}
+
+		result = append(result, mapped)
+	}
+
+	return result
+}
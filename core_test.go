@@ -0,0 +1,193 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"slices"
+)
+
+func TestCore(t *testing.T) {
+	tests := []struct {
+		Quads                     []Quad
+		ExpectedQuads             []string
+		ExpectedRemovedBlankNodes []string
+		ExpectedRemovedQuads      []string
+	}{
+		{
+			Quads: []Quad{
+				msgTestQuad(msgTestIRI("a"), msgTestIRI("p"), msgTestBlankNode("x")),
+				msgTestQuad(msgTestIRI("a"), msgTestIRI("p"), msgTestIRI("b")),
+			},
+			ExpectedQuads: []string{
+				`<http://example.com/a> <http://example.com/p> <http://example.com/b> .`,
+			},
+			ExpectedRemovedBlankNodes: []string{
+				`_:x`,
+			},
+			ExpectedRemovedQuads: []string{
+				`<http://example.com/a> <http://example.com/p> _:x .`,
+			},
+		},
+		{
+			// Already lean.
+			Quads: []Quad{
+				msgTestQuad(msgTestIRI("a"), msgTestIRI("p"), msgTestBlankNode("x")),
+				msgTestQuad(msgTestBlankNode("x"), msgTestIRI("q"), msgTestIRI("c")),
+				msgTestQuad(msgTestIRI("a"), msgTestIRI("p"), msgTestIRI("b")),
+			},
+			ExpectedQuads: []string{
+				`<http://example.com/a> <http://example.com/p> <http://example.com/b> .`,
+				`<http://example.com/a> <http://example.com/p> _:x .`,
+				`_:x <http://example.com/q> <http://example.com/c> .`,
+			},
+		},
+		{
+			// _:y is redundant with _:x.
+			Quads: []Quad{
+				msgTestQuad(msgTestIRI("a"), msgTestIRI("p"), msgTestBlankNode("x")),
+				msgTestQuad(msgTestBlankNode("x"), msgTestIRI("q"), msgTestIRI("c")),
+				msgTestQuad(msgTestIRI("a"), msgTestIRI("p"), msgTestBlankNode("y")),
+			},
+			ExpectedQuads: []string{
+				`<http://example.com/a> <http://example.com/p> _:x .`,
+				`_:x <http://example.com/q> <http://example.com/c> .`,
+			},
+			ExpectedRemovedBlankNodes: []string{
+				`_:y`,
+			},
+			ExpectedRemovedQuads: []string{
+				`<http://example.com/a> <http://example.com/p> _:y .`,
+			},
+		},
+		{
+			// A chain of blank-nodes that maps into a loop.
+			Quads: []Quad{
+				msgTestQuad(msgTestBlankNode("l"), msgTestIRI("next"), msgTestBlankNode("l")),
+				msgTestQuad(msgTestBlankNode("a"), msgTestIRI("next"), msgTestBlankNode("b")),
+				msgTestQuad(msgTestBlankNode("b"), msgTestIRI("next"), msgTestBlankNode("c")),
+			},
+			ExpectedQuads: []string{
+				`_:l <http://example.com/next> _:l .`,
+			},
+			ExpectedRemovedBlankNodes: []string{
+				`_:a`,
+				`_:b`,
+				`_:c`,
+			},
+			ExpectedRemovedQuads: []string{
+				`_:a <http://example.com/next> _:b .`,
+				`_:b <http://example.com/next> _:c .`,
+			},
+		},
+		{
+			// A directed cycle of 4 cannot be mapped into a directed cycle of 3 (nor the other way around), so both stay.
+			Quads: append(
+				msgTestCycle("a", "b", "c", "d"),
+				msgTestCycle("x", "y", "z")...,
+			),
+			ExpectedQuads: []string{
+				`_:a <http://example.com/next> _:b .`,
+				`_:b <http://example.com/next> _:c .`,
+				`_:c <http://example.com/next> _:d .`,
+				`_:d <http://example.com/next> _:a .`,
+				`_:x <http://example.com/next> _:y .`,
+				`_:y <http://example.com/next> _:z .`,
+				`_:z <http://example.com/next> _:x .`,
+			},
+		},
+		{
+			// A directed cycle of 6 maps onto a directed cycle of 3.
+			Quads: append(
+				msgTestCycle("a", "b", "c", "d", "e", "f"),
+				msgTestCycle("x", "y", "z")...,
+			),
+			ExpectedQuads: []string{
+				`_:x <http://example.com/next> _:y .`,
+				`_:y <http://example.com/next> _:z .`,
+				`_:z <http://example.com/next> _:x .`,
+			},
+			ExpectedRemovedBlankNodes: []string{
+				`_:a`,
+				`_:b`,
+				`_:c`,
+				`_:d`,
+				`_:e`,
+				`_:f`,
+			},
+			ExpectedRemovedQuads: []string{
+				`_:a <http://example.com/next> _:b .`,
+				`_:b <http://example.com/next> _:c .`,
+				`_:c <http://example.com/next> _:d .`,
+				`_:d <http://example.com/next> _:e .`,
+				`_:e <http://example.com/next> _:f .`,
+				`_:f <http://example.com/next> _:a .`,
+			},
+		},
+	}
+
+	for testNumber, test := range tests {
+		result, err := Core(test.Quads, 0)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error, but actually got one.", testNumber)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+
+		{
+			var actual []string
+			for _, quad := range result.Quads {
+				actual = append(actual, quad.String())
+			}
+
+			if expected := test.ExpectedQuads; !slices.Equal(expected, actual) {
+				t.Errorf("For test #%d, the actual core is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+
+		{
+			var actual []string
+			for _, identifier := range result.RemovedBlankNodes {
+				actual = append(actual, identifier.String())
+			}
+
+			if expected := test.ExpectedRemovedBlankNodes; !slices.Equal(expected, actual) {
+				t.Errorf("For test #%d, the actual removed blank-nodes are not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+
+		{
+			var actual []string
+			for _, quad := range result.RemovedQuads {
+				actual = append(actual, quad.String())
+			}
+
+			if expected := test.ExpectedRemovedQuads; !slices.Equal(expected, actual) {
+				t.Errorf("For test #%d, the actual removed quads are not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+	}
+}
+
+func TestCore_budget(t *testing.T) {
+	quads := append(
+		msgTestCycle("a", "b", "c", "d", "e", "f"),
+		msgTestCycle("x", "y", "z")...,
+	)
+
+	_, err := Core(quads, 1)
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED-ERROR: %s", ErrBudgetExceeded)
+		t.Logf("ACTUAL-ERROR:   %s", err)
+	}
+}
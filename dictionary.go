@@ -0,0 +1,474 @@
+package blanknode
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// DictionaryMagic is at the beginning of the file format of [Dictionary].
+const DictionaryMagic string = "BNDICT01"
+
+const dictionaryHeaderSize = len(DictionaryMagic) + 8
+
+// Dictionary assigns dense integer IDs (0, 1, 2, …) to blank-node-identifiers, and can look them up either way.
+// This is for "dictionary encoding" — for example, in a columnar triple store.
+//
+// For example:
+//
+//	var dictionary blanknode.Dictionary
+//
+//	id, err := dictionary.Add(identifier)
+//
+//	// ...
+//
+//	identifier, found := dictionary.Identifier(id)
+//
+// IDs are uint64.
+// For a store that uses uint32 IDs, use [Dictionary.Add32] and [Dictionary.ID32], which check that the IDs fit.
+//
+// A Dictionary can be frozen (see [Dictionary.Freeze]), after which no more blank-node-identifiers can be added.
+//
+// A Dictionary can be written out in a compact file format (see [Dictionary.WriteTo]),
+// which can be used in place — without decoding it — with [LoadDictionary] or [OpenDictionaryFile] (which memory-maps the file where it can).
+// This makes starting up fast, even for a large Dictionary.
+//
+// The zero value is ready to use.
+// It is safe to use from multiple goroutines.
+type Dictionary struct {
+	mutex       sync.RWMutex
+	ids         map[Identifier]uint64
+	identifiers []Identifier
+	frozen      bool
+	closed      bool
+
+	// view is set for a Dictionary that was loaded from the file format.
+	view  *dictionaryView
+	close func() error
+}
+
+// Add returns the ID of ‘identifier’, assigning it the next ID if it does not have one yet.
+//
+// It returns an error that wraps [ErrFrozen] if ‘identifier’ does not have an ID yet and the [Dictionary] is frozen,
+// and an error that wraps [ErrClosed] if the Dictionary is closed.
+//
+// See also: [Dictionary.Add32].
+func (receiver *Dictionary) Add(identifier Identifier) (uint64, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	return receiver.add(identifier, math.MaxUint64)
+}
+
+// Add32 is like [Dictionary.Add], except that the ID is a uint32 — for a store that uses 32-bit IDs.
+//
+// It returns an error that wraps [ErrIDOutOfRange] (and does not add ‘identifier’) if the next ID does not fit in a uint32 —
+// i.e., if the [Dictionary] already has 2³² blank-node-identifiers.
+//
+// (To look up the blank-node-identifier of a uint32 ID, use [Dictionary.Identifier] with uint64(id).)
+func (receiver *Dictionary) Add32(identifier Identifier) (uint32, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	id, err := receiver.add(identifier, math.MaxUint32)
+	if nil != err {
+		return 0, err
+	}
+
+	return uint32(id), nil
+}
+
+// add returns the ID of ‘identifier’, assigning it the next ID (if it is not greater than ‘limit’) if it does not have one yet.
+func (receiver *Dictionary) add(identifier Identifier, limit uint64) (uint64, error) {
+	if identifier.IsNothing() {
+		return 0, ErrEmptyIdentifier
+	}
+
+	{
+		receiver.mutex.RLock()
+		var closed bool = receiver.closed
+		id, found := receiver.id(identifier)
+		receiver.mutex.RUnlock()
+
+		if closed {
+			return 0, erorr.Errorf("dictionary: cannot add %s: %w", identifier, ErrClosed)
+		}
+		if found {
+			if limit < id {
+				return 0, erorr.Errorf("dictionary: ID %d of %s is over %d: %w", id, identifier, limit, ErrIDOutOfRange)
+			}
+			return id, nil
+		}
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if receiver.closed {
+		return 0, erorr.Errorf("dictionary: cannot add %s: %w", identifier, ErrClosed)
+	}
+	if id, found := receiver.id(identifier); found {
+		if limit < id {
+			return 0, erorr.Errorf("dictionary: ID %d of %s is over %d: %w", id, identifier, limit, ErrIDOutOfRange)
+		}
+		return id, nil
+	}
+	if receiver.frozen || nil != receiver.view {
+		return 0, erorr.Errorf("dictionary: cannot add %s: %w", identifier, ErrFrozen)
+	}
+
+	if nil == receiver.ids {
+		receiver.ids = map[Identifier]uint64{}
+	}
+
+	var id uint64 = uint64(len(receiver.identifiers))
+	if limit < id {
+		return 0, erorr.Errorf("dictionary: cannot add %s: next ID %d is over %d: %w", identifier, id, limit, ErrIDOutOfRange)
+	}
+	receiver.ids[identifier] = id
+	receiver.identifiers = append(receiver.identifiers, identifier)
+
+	return id, nil
+}
+
+// Close closes the [Dictionary] — releasing the memory-mapping of a Dictionary returned by [OpenDictionaryFile].
+//
+// The Dictionary must not be used after it is closed:
+// [Dictionary.Add] and [Dictionary.WriteTo] return an error that wraps [ErrClosed],
+// and [Dictionary.ID], [Dictionary.Identifier], and [Dictionary.Len] panic with [ErrClosed].
+//
+// Closing a Dictionary that is already closed does nothing.
+func (receiver *Dictionary) Close() error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if receiver.closed {
+		return nil
+	}
+	receiver.closed = true
+
+	var close func() error = receiver.close
+	receiver.close = nil
+	receiver.view = nil
+	receiver.ids = nil
+	receiver.identifiers = nil
+
+	if nil == close {
+		return nil
+	}
+
+	return close()
+}
+
+// Freeze freezes the [Dictionary], so that no more blank-node-identifiers can be added.
+func (receiver *Dictionary) Freeze() {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.frozen = true
+}
+
+// Frozen returns whether the [Dictionary] is frozen.
+//
+// A [Dictionary] loaded from the file format is always frozen.
+// (So is a closed Dictionary.)
+func (receiver *Dictionary) Frozen() bool {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	return receiver.frozen || receiver.closed || nil != receiver.view
+}
+
+// ID returns the ID of ‘identifier’, if it has one.
+func (receiver *Dictionary) ID(identifier Identifier) (uint64, bool) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	if receiver.closed {
+		panic(ErrClosed)
+	}
+
+	return receiver.id(identifier)
+}
+
+// ID32 is like [Dictionary.ID], except that the ID is a uint32 — for a store that uses 32-bit IDs.
+//
+// It returns false if ‘identifier’ does not have an ID, or if its ID does not fit in a uint32.
+// (An ID only does not fit if it was assigned by [Dictionary.Add] rather than [Dictionary.Add32].)
+func (receiver *Dictionary) ID32(identifier Identifier) (uint32, bool) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	id, found := receiver.ID(identifier)
+	if !found || math.MaxUint32 < id {
+		return 0, false
+	}
+
+	return uint32(id), true
+}
+
+// id returns the ID of ‘identifier’, if it has one.
+// The caller must hold the mutex.
+func (receiver *Dictionary) id(identifier Identifier) (uint64, bool) {
+	if nil != receiver.view {
+		label, found := identifier.label.Get()
+		if !found {
+			return 0, false
+		}
+		return receiver.view.id(label)
+	}
+
+	id, found := receiver.ids[identifier]
+	return id, found
+}
+
+// Identifier returns the blank-node-identifier with the ID ‘id’, if there is one.
+func (receiver *Dictionary) Identifier(id uint64) (Identifier, bool) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	if receiver.closed {
+		panic(ErrClosed)
+	}
+
+	if nil != receiver.view {
+		if receiver.view.count <= id {
+			return Identifier{}, false
+		}
+		return someIdentifier(someLabel(string(receiver.view.label(id)))), true
+	}
+
+	if uint64(len(receiver.identifiers)) <= id {
+		return Identifier{}, false
+	}
+
+	return receiver.identifiers[id], true
+}
+
+// Len returns how many blank-node-identifiers are in the [Dictionary].
+func (receiver *Dictionary) Len() int {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	if receiver.closed {
+		panic(ErrClosed)
+	}
+
+	if nil != receiver.view {
+		return int(receiver.view.count)
+	}
+
+	return len(receiver.identifiers)
+}
+
+// WriteTo makes [Dictionary] fit [io.WriterTo].
+//
+// It writes the [Dictionary] in its file format, which is (with all numbers being 64-bit little-endian):
+//
+// • the magic "BNDICT01",
+//
+// • the number of blank-node-identifiers, ‘count’,
+//
+// • ‘count’+1 offsets — the blank-node-label with ID ‘i’ is from offset ‘i’ to offset ‘i’+1 in the blank-node-labels,
+//
+// • ‘count’ IDs, sorted by their blank-node-labels (for looking up the ID of a blank-node-identifier by binary search), and
+//
+// • the blank-node-labels (without the "_:"), one after the other.
+//
+// See [LoadDictionary] and [OpenDictionaryFile] for using the file format.
+func (receiver *Dictionary) WriteTo(writer io.Writer) (int64, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	if receiver.closed {
+		return 0, erorr.Errorf("dictionary: %w", ErrClosed)
+	}
+
+	var count uint64
+	var labelOf func(uint64) []byte
+	if nil != receiver.view {
+		count = receiver.view.count
+		labelOf = receiver.view.label
+	} else {
+		count = uint64(len(receiver.identifiers))
+		labelOf = func(id uint64) []byte {
+			label, _ := receiver.identifiers[id].label.Get()
+			return []byte(label)
+		}
+	}
+
+	var counter dictionaryCountingWriter = dictionaryCountingWriter{writer: writer}
+	var buffered *bufio.Writer = bufio.NewWriter(&counter)
+
+	buffered.WriteString(DictionaryMagic)
+	buffered.Write(binary.LittleEndian.AppendUint64(nil, count))
+
+	{
+		var offset uint64
+		buffered.Write(binary.LittleEndian.AppendUint64(nil, offset))
+		for id := uint64(0); id < count; id++ {
+			offset += uint64(len(labelOf(id)))
+			buffered.Write(binary.LittleEndian.AppendUint64(nil, offset))
+		}
+	}
+
+	{
+		var sorted []uint64 = make([]uint64, count)
+		for id := range sorted {
+			sorted[id] = uint64(id)
+		}
+		slices.SortFunc(sorted, func(a uint64, b uint64) int {
+			return bytes.Compare(labelOf(a), labelOf(b))
+		})
+
+		for _, id := range sorted {
+			buffered.Write(binary.LittleEndian.AppendUint64(nil, id))
+		}
+	}
+
+	for id := uint64(0); id < count; id++ {
+		buffered.Write(labelOf(id))
+	}
+
+	err := buffered.Flush()
+	return counter.count, err
+}
+
+type dictionaryCountingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (receiver *dictionaryCountingWriter) Write(p []byte) (int, error) {
+	n, err := receiver.writer.Write(p)
+	receiver.count += int64(n)
+	return n, err
+}
+
+// LoadDictionary returns a (frozen) [Dictionary] that uses ‘data’ (in the file format written by [Dictionary.WriteTo]) in place.
+//
+// ‘data’ is not copied, so it must not be changed while the Dictionary is used.
+// Loading does not depend on the number of blank-node-identifiers — ‘data’ is only checked for its structure, not decoded.
+// (The blank-node-labels are not checked to be valid, and the sorted IDs are not checked to be sorted.)
+//
+// It returns an error that wraps [ErrDictionaryMalformed] if ‘data’ is not in the file format.
+func LoadDictionary(data []byte) (*Dictionary, error) {
+	view, err := newDictionaryView(data)
+	if nil != err {
+		return nil, err
+	}
+
+	return &Dictionary{
+		view: view,
+	}, nil
+}
+
+// dictionaryView is the file format of a Dictionary, used in place.
+type dictionaryView struct {
+	count   uint64
+	offsets []byte
+	sorted  []byte
+	labels  []byte
+}
+
+func newDictionaryView(data []byte) (*dictionaryView, error) {
+	if len(data) < dictionaryHeaderSize || DictionaryMagic != string(data[:len(DictionaryMagic)]) {
+		return nil, erorr.Errorf("dictionary: bad magic: %w", ErrDictionaryMalformed)
+	}
+
+	var count uint64 = binary.LittleEndian.Uint64(data[len(DictionaryMagic):])
+	var rest []byte = data[dictionaryHeaderSize:]
+
+	// (count+1) offsets and count IDs, each 8 bytes.
+	if uint64(len(rest))/16 < count || uint64(len(rest)) < 16*count+8 {
+		return nil, erorr.Errorf("dictionary: too short for %d blank-node-identifiers: %w", count, ErrDictionaryMalformed)
+	}
+
+	var view dictionaryView = dictionaryView{
+		count:   count,
+		offsets: rest[:8*(count+1)],
+		sorted:  rest[8*(count+1) : 8*(count+1)+8*count],
+		labels:  rest[8*(count+1)+8*count:],
+	}
+
+	if 0 != view.offset(0) || uint64(len(view.labels)) != view.offset(count) {
+		return nil, erorr.Errorf("dictionary: bad offsets: %w", ErrDictionaryMalformed)
+	}
+
+	return &view, nil
+}
+
+func (receiver *dictionaryView) offset(index uint64) uint64 {
+	return binary.LittleEndian.Uint64(receiver.offsets[8*index:])
+}
+
+func (receiver *dictionaryView) label(id uint64) []byte {
+	var begin uint64 = receiver.offset(id)
+	var end uint64 = receiver.offset(id + 1)
+
+	// Offsets are only checked at the ends when loading, so guard against bad ones in between.
+	if end < begin || uint64(len(receiver.labels)) < end {
+		return nil
+	}
+
+	return receiver.labels[begin:end]
+}
+
+func (receiver *dictionaryView) id(label string) (uint64, bool) {
+	var index int = sort.Search(int(receiver.count), func(i int) bool {
+		var id uint64 = binary.LittleEndian.Uint64(receiver.sorted[8*i:])
+		if receiver.count <= id {
+			return true
+		}
+		return strings.Compare(string(receiver.label(id)), label) >= 0
+	})
+	if int(receiver.count) <= index {
+		return 0, false
+	}
+
+	var id uint64 = binary.LittleEndian.Uint64(receiver.sorted[8*index:])
+	if receiver.count <= id || label != string(receiver.label(id)) {
+		return 0, false
+	}
+
+	return id, true
+}
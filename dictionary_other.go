@@ -0,0 +1,22 @@
+//go:build !unix
+
+package blanknode
+
+import (
+	"os"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// OpenDictionaryFile returns a (frozen) [Dictionary] from a file written by [Dictionary.WriteTo].
+//
+// On this platform the file is read into memory (rather than memory-mapped).
+// Call [Dictionary.Close] when done with the Dictionary.
+func OpenDictionaryFile(path string) (*Dictionary, error) {
+	data, err := os.ReadFile(path)
+	if nil != err {
+		return nil, erorr.Errorf("dictionary: %w", err)
+	}
+
+	return LoadDictionary(data)
+}
@@ -0,0 +1,384 @@
+package blanknode
+
+import (
+	"testing"
+
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+func TestDictionary(t *testing.T) {
+	var dictionary Dictionary
+
+	labels := []string{"b2", "b0", "address", "b2", "é", "b10", "b0"}
+	expected := []uint64{0, 1, 2, 0, 3, 4, 1}
+
+	for index, label := range labels {
+		actual, err := dictionary.Add(someIdentifier(someLabel(label)))
+		if nil != err {
+			t.Errorf("For %q, did not expect an error but actually got one.", label)
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+
+		if expected[index] != actual {
+			t.Errorf("For %q, the actual ID is not what was expected.", label)
+			t.Logf("EXPECTED: %d", expected[index])
+			t.Logf("ACTUAL:   %d", actual)
+			return
+		}
+	}
+
+	if expected, actual := 5, dictionary.Len(); expected != actual {
+		t.Errorf("The actual length is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+		return
+	}
+
+	if identifier, found := dictionary.Identifier(3); !found || "_:é" != identifier.String() {
+		t.Errorf("The actual blank-node-identifier for ID 3 is not what was expected.")
+		t.Logf("EXPECTED: %q", "_:é")
+		t.Logf("ACTUAL:   %q (found=%t)", identifier, found)
+		return
+	}
+
+	if _, found := dictionary.Identifier(5); found {
+		t.Errorf("Did not expect ID 5 to be found, but it actually was.")
+		return
+	}
+
+	dictionary.Freeze()
+
+	if id, err := dictionary.Add(someIdentifier(someLabel("b10"))); nil != err || 4 != id {
+		t.Errorf("Expected a blank-node-identifier that is already in a frozen dictionary to be found, but it actually was not.")
+		t.Logf("ID: %d", id)
+		t.Logf("ERROR: %v", err)
+		return
+	}
+
+	if _, err := dictionary.Add(someIdentifier(someLabel("new"))); !errors.Is(err, ErrFrozen) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrFrozen)
+		t.Logf("ACTUAL:   %v", err)
+		return
+	}
+}
+
+func TestDictionary_WriteTo(t *testing.T) {
+	var dictionary Dictionary
+	dictionary.Add(someIdentifier(someLabel("b1")))
+	dictionary.Add(someIdentifier(someLabel("a")))
+
+	var buffer bytes.Buffer
+	n, err := dictionary.WriteTo(&buffer)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	expected := []byte(
+		"BNDICT01" +
+		"\x02\x00\x00\x00\x00\x00\x00\x00" + // count
+		"\x00\x00\x00\x00\x00\x00\x00\x00" + // offsets
+		"\x02\x00\x00\x00\x00\x00\x00\x00" +
+		"\x03\x00\x00\x00\x00\x00\x00\x00" +
+		"\x01\x00\x00\x00\x00\x00\x00\x00" + // sorted IDs: "a", "b1"
+		"\x00\x00\x00\x00\x00\x00\x00\x00" +
+		"b1a",
+	)
+	actual := buffer.Bytes()
+
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("The actual file format is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+		return
+	}
+
+	if int64(len(expected)) != n {
+		t.Errorf("The actual number of bytes written is not what was expected.")
+		t.Logf("EXPECTED: %d", len(expected))
+		t.Logf("ACTUAL:   %d", n)
+	}
+}
+
+func TestOpenDictionaryFile(t *testing.T) {
+	const count = 1000
+
+	var dictionary Dictionary
+	for i := count - 1; 0 <= i; i-- {
+		dictionary.Add(someIdentifier(someLabel("n" + strconv.Itoa(i))))
+	}
+
+	var path string = filepath.Join(t.TempDir(), "blanknodes.dict")
+	{
+		file, err := os.Create(path)
+		if nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+		if _, err := dictionary.WriteTo(file); nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+		if err := file.Close(); nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+	}
+
+	loaded, err := OpenDictionaryFile(path)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	defer loaded.Close()
+
+	if !loaded.Frozen() {
+		t.Errorf("Expected the loaded dictionary to be frozen, but it actually was not.")
+	}
+	if expected, actual := count, loaded.Len(); expected != actual {
+		t.Errorf("The actual length is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+		return
+	}
+
+	for id := uint64(0); id < count; id++ {
+		expected, _ := dictionary.Identifier(id)
+
+		actual, found := loaded.Identifier(id)
+		if !found || expected != actual {
+			t.Errorf("For ID %d, the actual blank-node-identifier is not what was expected.", id)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q (found=%t)", actual, found)
+			return
+		}
+
+		actualID, found := loaded.ID(expected)
+		if !found || id != actualID {
+			t.Errorf("For %s, the actual ID is not what was expected.", expected)
+			t.Logf("EXPECTED: %d", id)
+			t.Logf("ACTUAL:   %d (found=%t)", actualID, found)
+			return
+		}
+	}
+
+	if _, found := loaded.ID(someIdentifier(someLabel("missing"))); found {
+		t.Errorf("Did not expect a missing blank-node-identifier to be found, but it actually was.")
+	}
+
+	if _, err := loaded.Add(someIdentifier(someLabel("missing"))); !errors.Is(err, ErrFrozen) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrFrozen)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func TestDictionary_Close(t *testing.T) {
+	var original Dictionary
+	for _, label := range []string{"b0", "b1", "b2"} {
+		original.Add(someIdentifier(someLabel(label)))
+	}
+
+	var path string = filepath.Join(t.TempDir(), "blanknodes.dict")
+	{
+		var buffer bytes.Buffer
+		if _, err := original.WriteTo(&buffer); nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+		if err := os.WriteFile(path, buffer.Bytes(), 0644); nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+	}
+
+	tests := []struct {
+		Name string
+		Open func() *Dictionary
+	}{
+		{
+			Name: "in-memory",
+			Open: func() *Dictionary {
+				var dictionary Dictionary
+				dictionary.Add(someIdentifier(someLabel("b0")))
+				return &dictionary
+			},
+		},
+		{
+			Name: "file",
+			Open: func() *Dictionary {
+				dictionary, err := OpenDictionaryFile(path)
+				if nil != err {
+					t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+				}
+				return dictionary
+			},
+		},
+	}
+
+	for _, test := range tests {
+		var dictionary *Dictionary = test.Open()
+
+		if err := dictionary.Close(); nil != err {
+			t.Errorf("For %s, did not expect an error from Close but actually got one.", test.Name)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+		if err := dictionary.Close(); nil != err {
+			t.Errorf("For %s, did not expect an error from closing again but actually got one.", test.Name)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if _, err := dictionary.Add(someIdentifier(someLabel("b0"))); !errors.Is(err, ErrClosed) {
+			t.Errorf("For %s, the actual error from Add (for an existing blank-node-identifier) is not what was expected.", test.Name)
+			t.Logf("EXPECTED: %s", ErrClosed)
+			t.Logf("ACTUAL:   %v", err)
+		}
+		if _, err := dictionary.Add(someIdentifier(someLabel("new"))); !errors.Is(err, ErrClosed) {
+			t.Errorf("For %s, the actual error from Add (for a new blank-node-identifier) is not what was expected.", test.Name)
+			t.Logf("EXPECTED: %s", ErrClosed)
+			t.Logf("ACTUAL:   %v", err)
+		}
+		if _, err := dictionary.WriteTo(&bytes.Buffer{}); !errors.Is(err, ErrClosed) {
+			t.Errorf("For %s, the actual error from WriteTo is not what was expected.", test.Name)
+			t.Logf("EXPECTED: %s", ErrClosed)
+			t.Logf("ACTUAL:   %v", err)
+		}
+		if !dictionary.Frozen() {
+			t.Errorf("For %s, expected a closed dictionary to be frozen, but it actually was not.", test.Name)
+		}
+
+		for name, fn := range map[string]func(){
+			"ID":         func() { dictionary.ID(someIdentifier(someLabel("b0"))) },
+			"ID32":       func() { dictionary.ID32(someIdentifier(someLabel("b0"))) },
+			"Identifier": func() { dictionary.Identifier(0) },
+			"Len":        func() { dictionary.Len() },
+		} {
+			func() {
+				defer func() {
+					if r := recover(); ErrClosed != r {
+						t.Errorf("For %s, the actual panic from %s is not what was expected.", test.Name, name)
+						t.Logf("EXPECTED: %v", ErrClosed)
+						t.Logf("ACTUAL:   %v", r)
+					}
+				}()
+
+				fn()
+			}()
+		}
+	}
+}
+
+func TestDictionary_Add32(t *testing.T) {
+	var dictionary Dictionary
+
+	for expected, label := range []string{"b0", "b1"} {
+		actual, err := dictionary.Add32(someIdentifier(someLabel(label)))
+		if nil != err {
+			t.Errorf("For %q, did not expect an error but actually got one.", label)
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+
+		if uint32(expected) != actual {
+			t.Errorf("For %q, the actual ID is not what was expected.", label)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			return
+		}
+
+		if id, found := dictionary.ID32(someIdentifier(someLabel(label))); !found || actual != id {
+			t.Errorf("For %q, the actual ID from ID32 is not what was expected.", label)
+			t.Logf("EXPECTED: %d", actual)
+			t.Logf("ACTUAL:   %d (found=%t)", id, found)
+			return
+		}
+	}
+
+	// With a limit of 1 (rather than math.MaxUint32), the next ID (2) does not fit.
+	if _, err := dictionary.add(someIdentifier(someLabel("b2")), 1); !errors.Is(err, ErrIDOutOfRange) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrIDOutOfRange)
+		t.Logf("ACTUAL:   %v", err)
+		return
+	}
+	if _, found := dictionary.ID(someIdentifier(someLabel("b2"))); found {
+		t.Errorf("Did not expect a blank-node-identifier whose ID does not fit to be added, but it actually was.")
+		return
+	}
+
+	// An existing ID that does not fit is not returned either.
+	if _, err := dictionary.add(someIdentifier(someLabel("b1")), 0); !errors.Is(err, ErrIDOutOfRange) {
+		t.Errorf("The actual error (for an existing blank-node-identifier) is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrIDOutOfRange)
+		t.Logf("ACTUAL:   %v", err)
+		return
+	}
+}
+
+func TestDictionary_nilReceiver(t *testing.T) {
+	var dictionary *Dictionary
+
+	for name, fn := range map[string]func(){
+		"Add":        func() { dictionary.Add(someIdentifier(someLabel("b0"))) },
+		"Add32":      func() { dictionary.Add32(someIdentifier(someLabel("b0"))) },
+		"Close":      func() { dictionary.Close() },
+		"Freeze":     func() { dictionary.Freeze() },
+		"Frozen":     func() { dictionary.Frozen() },
+		"ID":         func() { dictionary.ID(someIdentifier(someLabel("b0"))) },
+		"ID32":       func() { dictionary.ID32(someIdentifier(someLabel("b0"))) },
+		"Identifier": func() { dictionary.Identifier(0) },
+		"Len":        func() { dictionary.Len() },
+		"WriteTo":    func() { dictionary.WriteTo(&bytes.Buffer{}) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); ErrNilReceiver != r {
+					t.Errorf("The actual panic from %s is not what was expected.", name)
+					t.Logf("EXPECTED: %v", ErrNilReceiver)
+					t.Logf("ACTUAL:   %v", r)
+				}
+			}()
+
+			fn()
+		}()
+	}
+}
+
+func TestLoadDictionary_error(t *testing.T) {
+	tests := []struct{
+		Data []byte
+	}{
+		{
+			Data: nil,
+		},
+		{
+			Data: []byte("BNDICT02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"),
+		},
+		{
+			Data: []byte("BNDICT01\x05\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"),
+		},
+		{
+			Data: []byte("BNDICT01\xff\xff\xff\xff\xff\xff\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00"),
+		},
+		{
+			// The last offset does not match the length of the blank-node-labels.
+			Data: []byte("BNDICT01\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00"),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := LoadDictionary(test.Data)
+		if !errors.Is(err, ErrDictionaryMalformed) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", ErrDictionaryMalformed)
+			t.Logf("ACTUAL:   %v", err)
+			continue
+		}
+	}
+}
@@ -0,0 +1,46 @@
+//go:build unix
+
+package blanknode
+
+import (
+	"os"
+	"syscall"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// OpenDictionaryFile returns a (frozen) [Dictionary] from a file written by [Dictionary.WriteTo].
+//
+// The file is memory-mapped (read-only), so starting up is fast even for a large Dictionary — the operating-system only reads the parts that are used.
+// Call [Dictionary.Close] when done with the Dictionary.
+func OpenDictionaryFile(path string) (*Dictionary, error) {
+	file, err := os.Open(path)
+	if nil != err {
+		return nil, erorr.Errorf("dictionary: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if nil != err {
+		return nil, erorr.Errorf("dictionary: %w", err)
+	}
+	if info.Size() <= 0 {
+		return nil, erorr.Errorf("dictionary: empty file: %w", ErrDictionaryMalformed)
+	}
+
+	data, err := syscall.Mmap(int(file.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if nil != err {
+		return nil, erorr.Errorf("dictionary: %w", err)
+	}
+
+	dictionary, err := LoadDictionary(data)
+	if nil != err {
+		syscall.Munmap(data)
+		return nil, err
+	}
+	dictionary.close = func() error {
+		return syscall.Munmap(data)
+	}
+
+	return dictionary, nil
+}
@@ -0,0 +1,377 @@
+package blanknode
+
+import (
+	"cmp"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// GraphDiff is the difference between two versions of an RDF graph.
+//
+// See [DiffGraphs].
+type GraphDiff struct {
+	// Removed are the quads in the ‘before’ version that are not in the ‘after’ version, sorted by their N-Quads statement.
+	Removed []Quad
+
+	// Added are the quads in the ‘after’ version that are not in the ‘before’ version, sorted by their N-Quads statement.
+	// The blank-nodes in these quads have been aligned — see ‘Alignment’.
+	Added []Quad
+
+	// Alignment maps each blank-node in the ‘after’ version to the blank-node-identifier it has in ‘Added’.
+	//
+	// For a blank-node that was aligned with a blank-node in the ‘before’ version, this is the blank-node-identifier from the ‘before’ version.
+	Alignment map[Identifier]Identifier
+}
+
+// String makes [GraphDiff] fit [fmt.Stringer].
+//
+// It is the same as calling [GraphDiff.Unified] with "before" and "after".
+func (receiver GraphDiff) String() string {
+	return receiver.Unified("before", "after")
+}
+
+// Unified returns the difference as text, in the style of a unified diff.
+// For example:
+//
+//	--- before
+//	+++ after
+//	-_:b0 <http://example.com/city> "Vancouver" .
+//	+_:b0 <http://example.com/city> "Victoria" .
+//
+// The lines are sorted by N-Quads statement (and a removed quad comes before an added quad with the same N-Quads statement).
+func (receiver GraphDiff) Unified(beforeName string, afterName string) string {
+	var builder strings.Builder
+
+	builder.WriteString("--- ")
+	builder.WriteString(beforeName)
+	builder.WriteByte('\n')
+	builder.WriteString("+++ ")
+	builder.WriteString(afterName)
+	builder.WriteByte('\n')
+
+	var removed []Quad = receiver.Removed
+	var added []Quad = receiver.Added
+	for 0 < len(removed) || 0 < len(added) {
+		if 0 < len(removed) && (len(added) <= 0 || compareQuads(removed[0], added[0]) <= 0) {
+			builder.WriteByte('-')
+			builder.WriteString(removed[0].String())
+			builder.WriteByte('\n')
+			removed = removed[1:]
+			continue
+		}
+
+		builder.WriteByte('+')
+		builder.WriteString(added[0].String())
+		builder.WriteByte('\n')
+		added = added[1:]
+	}
+
+	return builder.String()
+}
+
+// DiffGraphs returns the difference between two versions of an RDF graph.
+//
+// The blank-node-labels in two versions of an RDF graph usually do not match up — even for parts of the RDF graph that did not change.
+// So, before comparing the quads, DiffGraphs aligns the blank-nodes in the ‘after’ version with the blank-nodes in the ‘before’ version:
+//
+// 1. Both versions are decomposed into Minimum Self-contained Graphs (see [MSGs]).
+//
+// 2. MSGs that are the same (other than how their blank-nodes are labeled) are matched up exactly, and their blank-nodes are aligned.
+//
+// 3. The remaining MSGs are matched up by similarity — i.e., by how many of their quads are the same if blank-nodes are ignored.
+// Within each pair of matched MSGs, blank-nodes are aligned by the similarity of the quads around them.
+//
+// Blank-nodes in the ‘after’ version that could not be aligned keep their blank-node-identifiers —
+// unless that blank-node-identifier is used by a (different) blank-node in the ‘before’ version, in which case it is given a new one (with "_" and a number added to the end).
+//
+// For example:
+//
+//	diff := blanknode.DiffGraphs(before, after)
+//
+//	fmt.Print(diff.Unified("2026-10-17.nq", "2026-10-18.nq"))
+func DiffGraphs(before []Quad, after []Quad) GraphDiff {
+	var alignment map[Identifier]Identifier = alignBlankNodes(before, after, 0)
+
+	// Blank-nodes in the ‘after’ version that were not aligned.
+	{
+		var used map[Identifier]struct{} = map[Identifier]struct{}{}
+		for _, identifier := range quadsBlankNodes(before) {
+			used[identifier] = struct{}{}
+		}
+
+		var unaligned []Identifier
+		for _, identifier := range quadsBlankNodes(after) {
+			if _, found := alignment[identifier]; !found {
+				unaligned = append(unaligned, identifier)
+			}
+		}
+
+		for _, identifier := range unaligned {
+			if _, found := used[identifier]; !found {
+				used[identifier] = struct{}{}
+				alignment[identifier] = identifier
+				continue
+			}
+
+			label, _ := identifier.label.Get()
+			for n := 1; ; n++ {
+				var fresh Identifier = someIdentifier(someLabel(label + "_" + strconv.Itoa(n)))
+				if _, found := used[fresh]; !found {
+					used[fresh] = struct{}{}
+					alignment[identifier] = fresh
+					break
+				}
+			}
+		}
+	}
+
+	var result GraphDiff = GraphDiff{
+		Alignment: alignment,
+	}
+	{
+		var mapping map[Identifier]Term = map[Identifier]Term{}
+		for from, to := range alignment {
+			mapping[from] = BlankNodeTerm(to)
+		}
+
+		var beforeSet map[Quad]struct{} = map[Quad]struct{}{}
+		for _, quad := range before {
+			beforeSet[quad] = struct{}{}
+		}
+
+		var afterSet map[Quad]struct{} = map[Quad]struct{}{}
+		for _, quad := range mapQuads(after, mapping) {
+			afterSet[quad] = struct{}{}
+
+			if _, found := beforeSet[quad]; !found {
+				result.Added = append(result.Added, quad)
+			}
+		}
+
+		for quad := range beforeSet {
+			if _, found := afterSet[quad]; !found {
+				result.Removed = append(result.Removed, quad)
+			}
+		}
+
+		slices.SortFunc(result.Added, compareQuads)
+		slices.SortFunc(result.Removed, compareQuads)
+	}
+
+	return result
+}
+
+// alignBlankNodes aligns the blank-nodes in ‘after’ with the blank-nodes in ‘before’, and returns a map from the former to the latter.
+// Blank-nodes that could not be aligned are not in the map.
+//
+// Blank-nodes in MSGs that are the same (other than how their blank-nodes are labeled) are aligned exactly.
+// Blank-nodes in the other MSGs are aligned by the similarity of the quads around them — if that similarity is at least ‘minimumSimilarity’ (from 0 to 1).
+func alignBlankNodes(before []Quad, after []Quad, minimumSimilarity float64) map[Identifier]Identifier {
+	var beforeMSGs []MSG = MSGs(before)
+	var afterMSGs []MSG = MSGs(after)
+
+	var alignment map[Identifier]Identifier = map[Identifier]Identifier{}
+
+	var unmatchedBefore []MSG
+	var unmatchedAfter []MSG
+	{
+		var beforeByDigest map[string][]int = map[string][]int{}
+		var canonicalizations []canonicalization = make([]canonicalization, len(beforeMSGs))
+		for index, msg := range beforeMSGs {
+			canonicalizations[index] = canonicalize(msg.Quads)
+
+			var digest string = hex.EncodeToString(canonicalizations[index].digest())
+			beforeByDigest[digest] = append(beforeByDigest[digest], index)
+		}
+
+		var matched map[int]struct{} = map[int]struct{}{}
+		for _, msg := range afterMSGs {
+			var c canonicalization = canonicalize(msg.Quads)
+			var digest string = hex.EncodeToString(c.digest())
+
+			var candidates []int = beforeByDigest[digest]
+			if len(candidates) <= 0 {
+				unmatchedAfter = append(unmatchedAfter, msg)
+				continue
+			}
+			var index int = candidates[0]
+			beforeByDigest[digest] = candidates[1:]
+			matched[index] = struct{}{}
+
+			var byLabel map[string]Identifier = map[string]Identifier{}
+			for identifier, label := range canonicalizations[index].labels {
+				byLabel[label] = identifier
+			}
+			for identifier, label := range c.labels {
+				alignment[identifier] = byLabel[label]
+			}
+		}
+
+		for index, msg := range beforeMSGs {
+			if _, found := matched[index]; !found {
+				unmatchedBefore = append(unmatchedBefore, msg)
+			}
+		}
+	}
+
+	for _, pair := range matchBySimilarity(unmatchedBefore, unmatchedAfter, msgSignature, msgSignature) {
+		beforeSignature := func(identifier Identifier) []string {
+			return blankNodeSignature(pair.before.Quads, identifier)
+		}
+		afterSignature := func(identifier Identifier) []string {
+			return blankNodeSignature(pair.after.Quads, identifier)
+		}
+
+		for _, blankNodePair := range matchBySimilarity(msgBlankNodes(pair.before), msgBlankNodes(pair.after), beforeSignature, afterSignature) {
+			if blankNodePair.similarity < minimumSimilarity {
+				continue
+			}
+
+			alignment[blankNodePair.after] = blankNodePair.before
+		}
+	}
+
+	return alignment
+}
+
+func msgBlankNodes(msg MSG) []Identifier {
+	return quadsBlankNodes(msg.Quads)
+}
+
+// quadsBlankNodes returns the (distinct) blank-nodes in ‘quads’, in the order of [Compare].
+func quadsBlankNodes(quads []Quad) []Identifier {
+	var identifiers []Identifier
+	var seen map[Identifier]struct{} = map[Identifier]struct{}{}
+	for _, quad := range quads {
+		for _, identifier := range quad.BlankNodes() {
+			if _, found := seen[identifier]; found {
+				continue
+			}
+			seen[identifier] = struct{}{}
+
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	SortIdentifiers(identifiers)
+
+	return identifiers
+}
+
+// msgSignature returns the N-Quads statements of the MSG, with the blank-nodes made anonymous.
+func msgSignature(msg MSG) []string {
+	var strs []string
+	for _, quad := range msg.Quads {
+		strs = append(strs, quad.format(func(Identifier) string {
+			return "_:"
+		}))
+	}
+
+	return strs
+}
+
+// blankNodeSignature returns the N-Quads statements of the quads ‘identifier’ is in, with ‘identifier’ as "_:self", and the other blank-nodes made anonymous.
+func blankNodeSignature(quads []Quad, identifier Identifier) []string {
+	var strs []string
+	for _, quad := range quads {
+		if !slices.Contains(quad.BlankNodes(), identifier) {
+			continue
+		}
+
+		strs = append(strs, quad.format(func(other Identifier) string {
+			if other == identifier {
+				return "_:self"
+			}
+			return "_:"
+		}))
+	}
+
+	return strs
+}
+
+// similarity returns the Jaccard similarity of two (multi)sets of strings.
+func similarity(a []string, b []string) float64 {
+	var counts map[string]int = map[string]int{}
+	for _, str := range a {
+		counts[str]++
+	}
+
+	var intersection int
+	for _, str := range b {
+		if 0 < counts[str] {
+			counts[str]--
+			intersection++
+		}
+	}
+
+	var union int = len(a) + len(b) - intersection
+	if union <= 0 {
+		return 0
+	}
+
+	return float64(intersection) / float64(union)
+}
+
+type similarityPair[T any] struct {
+	before     T
+	after      T
+	similarity float64
+	order      [2]int
+}
+
+// matchBySimilarity greedily matches up elements of ‘before’ with elements of ‘after’, most similar first.
+// Elements with nothing in common are not matched up.
+func matchBySimilarity[T any](before []T, after []T, beforeSignature func(T) []string, afterSignature func(T) []string) []similarityPair[T] {
+	var afterSignatures [][]string = make([][]string, len(after))
+	for j, element := range after {
+		afterSignatures[j] = afterSignature(element)
+	}
+
+	var pairs []similarityPair[T]
+	for i, b := range before {
+		var signature []string = beforeSignature(b)
+
+		for j, a := range after {
+			var s float64 = similarity(signature, afterSignatures[j])
+			if s <= 0 {
+				continue
+			}
+
+			pairs = append(pairs, similarityPair[T]{
+				before:     b,
+				after:      a,
+				similarity: s,
+				order:      [2]int{i, j},
+			})
+		}
+	}
+
+	slices.SortStableFunc(pairs, func(x similarityPair[T], y similarityPair[T]) int {
+		if result := cmp.Compare(y.similarity, x.similarity); 0 != result {
+			return result
+		}
+		if result := cmp.Compare(x.order[0], y.order[0]); 0 != result {
+			return result
+		}
+		return cmp.Compare(x.order[1], y.order[1])
+	})
+
+	var result []similarityPair[T]
+	var usedBefore map[int]struct{} = map[int]struct{}{}
+	var usedAfter map[int]struct{} = map[int]struct{}{}
+	for _, pair := range pairs {
+		if _, found := usedBefore[pair.order[0]]; found {
+			continue
+		}
+		if _, found := usedAfter[pair.order[1]]; found {
+			continue
+		}
+		usedBefore[pair.order[0]] = struct{}{}
+		usedAfter[pair.order[1]] = struct{}{}
+
+		result = append(result, pair)
+	}
+
+	return result
+}
@@ -0,0 +1,97 @@
+package blanknode
+
+import (
+	"testing"
+)
+
+func TestDiffGraphs(t *testing.T) {
+	tests := []struct {
+		Before   []Quad
+		After    []Quad
+		Expected string
+	}{
+		{
+			// Only the blank-node-labels changed.
+			Before: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b0")),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+				msgTestQuad(msgTestIRI("jane"), msgTestIRI("address"), msgTestBlankNode("b1")),
+				msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("city"), GroundTerm(`"Victoria"`)),
+			},
+			After: []Quad{
+				msgTestQuad(msgTestIRI("jane"), msgTestIRI("address"), msgTestBlankNode("b0")),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Victoria"`)),
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b1")),
+				msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+			},
+			Expected:
+				"--- before\n"+
+				"+++ after\n",
+		},
+		{
+			// A blank-node changed, and was relabeled.
+			Before: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b0")),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("street"), GroundTerm(`"Main"`)),
+				msgTestQuad(msgTestIRI("jane"), msgTestIRI("address"), msgTestBlankNode("b1")),
+				msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("city"), GroundTerm(`"Victoria"`)),
+			},
+			After: []Quad{
+				msgTestQuad(msgTestIRI("jane"), msgTestIRI("address"), msgTestBlankNode("b0")),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Victoria"`)),
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b1")),
+				msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("city"), GroundTerm(`"Surrey"`)),
+				msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("street"), GroundTerm(`"Main"`)),
+			},
+			Expected:
+				"--- before\n"+
+				"+++ after\n"+
+				"+_:b0 <http://example.com/city> \"Surrey\" .\n"+
+				"-_:b0 <http://example.com/city> \"Vancouver\" .\n",
+		},
+		{
+			// A new blank-node, whose blank-node-label was used by a different blank-node before.
+			Before: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b0")),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+			},
+			After: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b1")),
+				msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("knows"), msgTestBlankNode("b0")),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("name"), GroundTerm(`"Jane"`)),
+			},
+			Expected:
+				"--- before\n"+
+				"+++ after\n"+
+				"+<http://example.com/joe> <http://example.com/knows> _:b0_1 .\n"+
+				"+_:b0_1 <http://example.com/name> \"Jane\" .\n",
+		},
+		{
+			// Removed, and added, quads without blank-nodes.
+			Before: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+			},
+			After: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joseph"`)),
+			},
+			Expected:
+				"--- before\n"+
+				"+++ after\n"+
+				"-<http://example.com/joe> <http://example.com/name> \"Joe\" .\n"+
+				"+<http://example.com/joe> <http://example.com/name> \"Joseph\" .\n",
+		},
+	}
+
+	for testNumber, test := range tests {
+		actual := DiffGraphs(test.Before, test.After).String()
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the actual diff is not what was expected.", testNumber)
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			continue
+		}
+	}
+}
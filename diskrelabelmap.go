@@ -0,0 +1,355 @@
+package blanknode
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"os"
+	"sync"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+const (
+	diskRelabelMapSlotSize        = 32
+	diskRelabelMapInitialCapacity = 1 << 12
+	diskRelabelMapGrowChunk       = 1 << 10
+	diskRelabelMapProbeChunk      = 1 << 6
+)
+
+// DiskRelabelMap is a [RelabelMap] that keeps (almost) everything on disk, so that it uses a bounded amount of memory no matter how many blank-nodes are relabeled.
+// This is for when there are too many blank-nodes to keep in memory — for example, relabeling a Wikidata-scale dump.
+//
+// The new blank-node-identifiers are "_:b0", "_:b1", "_:b2", etc, in the order the blank-node-identifiers are first relabeled — the same as with [MemoryRelabelMap].
+//
+// It is an on-disk hash table (with open addressing and linear probing), and a log of the blank-node-labels.
+// When the hash table gets half full, it is rebuilt at twice the size.
+// Caching is left to the operating-system (which is usually good at it).
+//
+// The files are temporary — they are removed by [DiskRelabelMap.Close].
+//
+// For example:
+//
+//	relabelMap, err := blanknode.OpenDiskRelabelMap("/var/tmp")
+//	if nil != err {
+//		return err
+//	}
+//	defer relabelMap.Close()
+//
+//	// ...
+//
+//	relabeled, err := relabelMap.Relabel(identifier)
+//
+// It is safe to use from multiple goroutines.
+type DiskRelabelMap struct {
+	mutex sync.Mutex
+
+	directory string
+
+	// labels is the log of the blank-node-labels.
+	labels     *os.File
+	labelsSize int64
+
+	// table is the hash table.
+	// Each slot is: the hash of the blank-node-label (0 for an empty slot), the offset and length of the blank-node-label in ‘labels’, and the number of the new blank-node-identifier.
+	table    *os.File
+	capacity uint64
+	count    uint64
+
+	closed bool
+}
+
+type diskRelabelMapSlot struct {
+	hash   uint64
+	offset uint64
+	length uint64
+	value  uint64
+}
+
+func (receiver *diskRelabelMapSlot) decode(data []byte) {
+	receiver.hash = binary.LittleEndian.Uint64(data[0:8])
+	receiver.offset = binary.LittleEndian.Uint64(data[8:16])
+	receiver.length = binary.LittleEndian.Uint64(data[16:24])
+	receiver.value = binary.LittleEndian.Uint64(data[24:32])
+}
+
+func (receiver diskRelabelMapSlot) encode(data []byte) {
+	binary.LittleEndian.PutUint64(data[0:8], receiver.hash)
+	binary.LittleEndian.PutUint64(data[8:16], receiver.offset)
+	binary.LittleEndian.PutUint64(data[16:24], receiver.length)
+	binary.LittleEndian.PutUint64(data[24:32], receiver.value)
+}
+
+// OpenDiskRelabelMap returns a new (empty) [DiskRelabelMap], with its (temporary) files in ‘directory’.
+//
+// If ‘directory’ is "", then the default directory for temporary files is used (see [os.TempDir]).
+func OpenDiskRelabelMap(directory string) (*DiskRelabelMap, error) {
+	labels, err := os.CreateTemp(directory, "blanknode-relabel-labels-*")
+	if nil != err {
+		return nil, erorr.Errorf("disk-relabel-map: %w", err)
+	}
+
+	table, err := newDiskRelabelMapTable(directory, diskRelabelMapInitialCapacity)
+	if nil != err {
+		labels.Close()
+		os.Remove(labels.Name())
+		return nil, err
+	}
+
+	return &DiskRelabelMap{
+		directory: directory,
+		labels:    labels,
+		table:     table,
+		capacity:  diskRelabelMapInitialCapacity,
+	}, nil
+}
+
+func newDiskRelabelMapTable(directory string, capacity uint64) (*os.File, error) {
+	table, err := os.CreateTemp(directory, "blanknode-relabel-table-*")
+	if nil != err {
+		return nil, erorr.Errorf("disk-relabel-map: %w", err)
+	}
+
+	if err := table.Truncate(int64(capacity * diskRelabelMapSlotSize)); nil != err {
+		table.Close()
+		os.Remove(table.Name())
+		return nil, erorr.Errorf("disk-relabel-map: %w", err)
+	}
+
+	return table, nil
+}
+
+// Close closes the [DiskRelabelMap], and removes its files.
+func (receiver *DiskRelabelMap) Close() error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if receiver.closed {
+		return nil
+	}
+	receiver.closed = true
+
+	var errs []error
+	for _, file := range []*os.File{receiver.labels, receiver.table} {
+		if err := file.Close(); nil != err {
+			errs = append(errs, err)
+		}
+		if err := os.Remove(file.Name()); nil != err {
+			errs = append(errs, err)
+		}
+	}
+	if 0 < len(errs) {
+		return erorr.Errorf("disk-relabel-map: %w", errs[0])
+	}
+
+	return nil
+}
+
+// Len returns how many blank-node-identifiers have been relabeled.
+func (receiver *DiskRelabelMap) Len() int {
+	if nil == receiver {
+		return 0
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return int(receiver.count)
+}
+
+// Relabel returns the new blank-node-identifier for ‘identifier’, assigning one if ‘identifier’ has not been relabeled before.
+func (receiver *DiskRelabelMap) Relabel(identifier Identifier) (Identifier, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	label, found := identifier.Get()
+	if !found {
+		return Identifier{}, ErrEmptyIdentifier
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if receiver.closed {
+		return Identifier{}, ErrClosed
+	}
+
+	var hash uint64 = diskRelabelMapHash(label)
+
+	index, value, found, err := receiver.lookup(hash, label)
+	if nil != err {
+		return Identifier{}, err
+	}
+	if found {
+		return relabelMapIdentifier(value), nil
+	}
+
+	// Grow before inserting (rather than after), so that if growing fails, nothing has been written.
+	if receiver.capacity < (receiver.count+1)*2 {
+		if err := receiver.grow(); nil != err {
+			return Identifier{}, err
+		}
+
+		index, _, _, err = receiver.lookup(hash, label)
+		if nil != err {
+			return Identifier{}, err
+		}
+	}
+
+	return receiver.insert(index, hash, label)
+}
+
+// lookup returns the number of the new blank-node-identifier for ‘label’ (whose hash is ‘hash’), if it is in the hash table.
+// If it is not, then it returns the index of the empty slot where it would go.
+func (receiver *DiskRelabelMap) lookup(hash uint64, label string) (index uint64, value uint64, found bool, err error) {
+	err = diskRelabelMapProbe(receiver.table, receiver.capacity, hash, func(i uint64, slot diskRelabelMapSlot) (bool, error) {
+		if 0 == slot.hash {
+			index = i
+			return true, nil
+		}
+
+		if hash != slot.hash || uint64(len(label)) != slot.length {
+			return false, nil
+		}
+
+		var stored []byte = make([]byte, slot.length)
+		if _, err := receiver.labels.ReadAt(stored, int64(slot.offset)); nil != err {
+			return false, erorr.Errorf("disk-relabel-map: %w", err)
+		}
+		if label != string(stored) {
+			return false, nil
+		}
+
+		value = slot.value
+		found = true
+		return true, nil
+	})
+
+	return index, value, found, err
+}
+
+// insert puts ‘label’ in the empty slot ‘index’.
+func (receiver *DiskRelabelMap) insert(index uint64, hash uint64, label string) (Identifier, error) {
+	var slot diskRelabelMapSlot = diskRelabelMapSlot{
+		hash:   hash,
+		offset: uint64(receiver.labelsSize),
+		length: uint64(len(label)),
+		value:  receiver.count,
+	}
+
+	if _, err := receiver.labels.WriteAt([]byte(label), receiver.labelsSize); nil != err {
+		return Identifier{}, erorr.Errorf("disk-relabel-map: %w", err)
+	}
+
+	var data [diskRelabelMapSlotSize]byte
+	slot.encode(data[:])
+	if _, err := receiver.table.WriteAt(data[:], int64(index*diskRelabelMapSlotSize)); nil != err {
+		return Identifier{}, erorr.Errorf("disk-relabel-map: %w", err)
+	}
+
+	receiver.labelsSize += int64(len(label))
+	receiver.count++
+
+	return relabelMapIdentifier(slot.value), nil
+}
+
+// grow rebuilds the hash table at twice the size.
+func (receiver *DiskRelabelMap) grow() error {
+	var capacity uint64 = receiver.capacity * 2
+
+	table, err := newDiskRelabelMapTable(receiver.directory, capacity)
+	if nil != err {
+		return err
+	}
+
+	var chunk []byte = make([]byte, diskRelabelMapGrowChunk*diskRelabelMapSlotSize)
+	for start := uint64(0); start < receiver.capacity; start += diskRelabelMapGrowChunk {
+		if _, err := receiver.table.ReadAt(chunk, int64(start*diskRelabelMapSlotSize)); nil != err {
+			table.Close()
+			os.Remove(table.Name())
+			return erorr.Errorf("disk-relabel-map: %w", err)
+		}
+
+		for offset := 0; offset < len(chunk); offset += diskRelabelMapSlotSize {
+			var slot diskRelabelMapSlot
+			slot.decode(chunk[offset : offset+diskRelabelMapSlotSize])
+			if 0 == slot.hash {
+				continue
+			}
+
+			var empty uint64
+			err := diskRelabelMapProbe(table, capacity, slot.hash, func(index uint64, slot diskRelabelMapSlot) (bool, error) {
+				empty = index
+				return 0 == slot.hash, nil
+			})
+			if nil == err {
+				_, err = table.WriteAt(chunk[offset:offset+diskRelabelMapSlotSize], int64(empty*diskRelabelMapSlotSize))
+			}
+			if nil != err {
+				table.Close()
+				os.Remove(table.Name())
+				return erorr.Errorf("disk-relabel-map: %w", err)
+			}
+		}
+	}
+
+	var old *os.File = receiver.table
+	receiver.table = table
+	receiver.capacity = capacity
+
+	old.Close()
+	os.Remove(old.Name())
+
+	return nil
+}
+
+// diskRelabelMapProbe calls ‘fn’ with each slot of ‘table’ (which has ‘capacity’ slots), in the order of linear probing for ‘hash’, until ‘fn’ returns true.
+//
+// The slots are read in chunks (of diskRelabelMapProbeChunk slots), rather than one at a time.
+// The hash table is never more than half full, so there is always an empty slot to stop at.
+func diskRelabelMapProbe(table *os.File, capacity uint64, hash uint64, fn func(index uint64, slot diskRelabelMapSlot) (bool, error)) error {
+	var mask uint64 = capacity - 1
+	var chunk [diskRelabelMapProbeChunk * diskRelabelMapSlotSize]byte
+
+	for index := hash & mask; ; {
+		// A chunk does not go past the end of the table — the probing wraps around to the beginning after it.
+		var count uint64 = min(diskRelabelMapProbeChunk, capacity-index)
+		var data []byte = chunk[:count*diskRelabelMapSlotSize]
+
+		if _, err := table.ReadAt(data, int64(index*diskRelabelMapSlotSize)); nil != err {
+			return erorr.Errorf("disk-relabel-map: %w", err)
+		}
+
+		for i := uint64(0); i < count; i++ {
+			var slot diskRelabelMapSlot
+			slot.decode(data[i*diskRelabelMapSlotSize : (i+1)*diskRelabelMapSlotSize])
+
+			done, err := fn(index+i, slot)
+			if nil != err {
+				return err
+			}
+			if done {
+				return nil
+			}
+		}
+
+		index = (index + count) & mask
+	}
+}
+
+// diskRelabelMapHash returns the (64-bit FNV-1a) hash of ‘label’ — never 0, since 0 marks an empty slot.
+func diskRelabelMapHash(label string) uint64 {
+	var h = fnv.New64a()
+	h.Write([]byte(label))
+
+	var hash uint64 = h.Sum64()
+	if 0 == hash {
+		hash = 1
+	}
+
+	return hash
+}
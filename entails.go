@@ -0,0 +1,33 @@
+package blanknode
+
+// Entails returns whether the RDF graph ‘g’ simply entails the RDF graph ‘h’.
+//
+// From RDF 1.1 Semantics (https://www.w3.org/TR/rdf11-mt/#simple-entailment):
+//
+//	G simply entails a graph E if and only if a subgraph of G is an instance of E.
+//
+// I.e., ‘g’ simply entails ‘h’ if there is a mapping of the blank-nodes in ‘h’ to terms in ‘g’, such that ‘h’ (with the mapping applied) is a subgraph of ‘g’.
+//
+// If ‘g’ simply entails ‘h’, then Entails also returns that mapping — the witness.
+// The mapping has an entry for each blank-node in ‘h’.
+//
+// The blank-nodes in ‘g’ and the blank-nodes in ‘h’ are different blank-nodes — even if they have the same blank-node-identifiers.
+// (So, for example, a blank-node "_:b0" in ‘h’ might be mapped to a blank-node "_:b7" in ‘g’, or to a blank-node "_:b0" in ‘g’, or to something else.)
+//
+// Entails searches for the mapping using constraint propagation with backtracking.
+// The problem is NP-hard in general, so this can take exponential time.
+//
+// For example:
+//
+//	mapping, entailed := blanknode.Entails(g, h)
+//	if !entailed {
+//		return errNotValid
+//	}
+func Entails(g []Quad, h []Quad) (map[Identifier]Term, bool) {
+	mapping, found, _ := newHomomorphism(h, g, nil).find()
+	if !found {
+		return nil, false
+	}
+
+	return mapping, true
+}
@@ -0,0 +1,113 @@
+package blanknode
+
+import (
+	"testing"
+)
+
+func TestEntails(t *testing.T) {
+	g := []Quad{
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b0")),
+		msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("knows"), msgTestIRI("jane")),
+		msgTestQuad(msgTestIRI("jane"), msgTestIRI("knows"), msgTestIRI("joe")),
+	}
+
+	tests := []struct {
+		H                []Quad
+		ExpectedEntailed bool
+		ExpectedMapping  map[string]string
+	}{
+		{
+			ExpectedEntailed: true,
+			ExpectedMapping:  map[string]string{},
+		},
+		{
+			H: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+			},
+			ExpectedEntailed: true,
+			ExpectedMapping:  map[string]string{},
+		},
+		{
+			H: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joseph"`)),
+			},
+			ExpectedEntailed: false,
+		},
+		{
+			H: []Quad{
+				msgTestQuad(msgTestBlankNode("someone"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+			},
+			ExpectedEntailed: true,
+			ExpectedMapping: map[string]string{
+				"_:someone": "<http://example.com/joe>",
+			},
+		},
+		{
+			// The "_:b0" in ‘h’ is not the "_:b0" in ‘g’.
+			H: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("x")),
+				msgTestQuad(msgTestBlankNode("x"), msgTestIRI("city"), msgTestBlankNode("b0")),
+			},
+			ExpectedEntailed: true,
+			ExpectedMapping: map[string]string{
+				"_:x":  "_:b0",
+				"_:b0": `"Vancouver"`,
+			},
+		},
+		{
+			H: []Quad{
+				msgTestQuad(msgTestBlankNode("x"), msgTestIRI("knows"), msgTestBlankNode("y")),
+				msgTestQuad(msgTestBlankNode("y"), msgTestIRI("knows"), msgTestBlankNode("x")),
+				msgTestQuad(msgTestBlankNode("y"), msgTestIRI("address"), msgTestBlankNode("z")),
+			},
+			ExpectedEntailed: true,
+			ExpectedMapping: map[string]string{
+				"_:x": "<http://example.com/jane>",
+				"_:y": "<http://example.com/joe>",
+				"_:z": "_:b0",
+			},
+		},
+		{
+			H: []Quad{
+				msgTestQuad(msgTestBlankNode("x"), msgTestIRI("knows"), msgTestBlankNode("x")),
+			},
+			ExpectedEntailed: false,
+		},
+	}
+
+	for testNumber, test := range tests {
+		mapping, entailed := Entails(g, test.H)
+
+		if expected, actual := test.ExpectedEntailed, entailed; expected != actual {
+			t.Errorf("For test #%d, whether it is entailed is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %t", expected)
+			t.Logf("ACTUAL:   %t", actual)
+			continue
+		}
+		if !entailed {
+			continue
+		}
+
+		var actual map[string]string = map[string]string{}
+		for identifier, term := range mapping {
+			actual[identifier.String()] = term.String()
+		}
+
+		if expected := test.ExpectedMapping; len(expected) != len(actual) {
+			t.Errorf("For test #%d, the actual mapping is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+		for key, expected := range test.ExpectedMapping {
+			if actual := actual[key]; expected != actual {
+				t.Errorf("For test #%d, the actual mapping for %q is not what was expected.", testNumber, key)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+	}
+}
@@ -5,11 +5,38 @@ import (
 )
 
 const (
+	ErrBudgetExceeded                = erorr.Error("budget exceeded")
+	ErrChecksumMismatch              = erorr.Error("checksum mismatch")
+	ErrClockSkew                     = erorr.Error("clock skew")
+	ErrClosed                        = erorr.Error("closed")
+	ErrDictionaryMalformed           = erorr.Error("dictionary malformed")
+	ErrIDOutOfRange                  = erorr.Error("id out of range")
 	ErrIdentifierPrefixNotFound      = erorr.Error("blank-node-identifier prefix (\"_:\") not found")
+	ErrKeyMalformed                  = erorr.Error("key malformed")
+	ErrLabelMapMalformed             = erorr.Error("label-map malformed")
+	ErrLabelCharacterNotAllowed      = erorr.Error("blank-node-label character not allowed")
 	ErrLabelFirstCharacterNotAllowed = erorr.Error("blank-node-label first character not allowed")
+	ErrLabelInvalidUTF8              = erorr.Error("blank-node-label invalid utf-8")
 	ErrLabelLastCharacterNotAllowed  = erorr.Error("blank-node-label last character not allowed")
+	ErrLabelNotASCII                 = erorr.Error("blank-node-label not ascii")
+	ErrLabelScriptNotAllowed         = erorr.Error("blank-node-label script not allowed")
+	ErrLabelTooManyBytes             = erorr.Error("blank-node-label has too many bytes")
+	ErrLabelTooManyRunes             = erorr.Error("blank-node-label has too many runes")
+	ErrEmptyKey                      = erorr.Error("empty key")
 	ErrEmptyIdentifier               = erorr.Error("empty blank-node-identifier")
 	ErrEmptyLabel                    = erorr.Error("empty blank-node-label")
+	ErrEmptyTerm                     = erorr.Error("empty term")
 	ErrEmptyString                   = erorr.Error("empty string")
+	ErrFrozen                        = erorr.Error("frozen")
+	ErrGeneratorStateMalformed       = erorr.Error("generator state malformed")
+	ErrHashCollision                 = erorr.Error("hash collision")
+	ErrNilHash                       = erorr.Error("nil hash")
 	ErrNilReceiver                   = erorr.Error("nil receiver")
+	ErrNotFound                      = erorr.Error("not found")
+	ErrPatchRowMalformed             = erorr.Error("rdf-patch row malformed")
+	ErrPFCSectionMalformed           = erorr.Error("pfc section malformed")
+	ErrSnowflakeExhausted            = erorr.Error("snowflake timestamps exhausted")
+	ErrSnowflakeLabelMalformed       = erorr.Error("snowflake blank-node-label malformed")
+	ErrUnknownEncoding               = erorr.Error("unknown encoding")
+	ErrWorkerIDOutOfRange            = erorr.Error("worker-id out of range")
 )
@@ -0,0 +1,124 @@
+//go:build ignore
+
+// gen_confusables generates confusables_table.go from testdata/unicode/confusables.txt.
+//
+// It is run by "go generate".
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	input  = "testdata/unicode/confusables.txt"
+	output = "confusables_table.go"
+)
+
+func main() {
+	file, err := os.Open(input)
+	if nil != err {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var version string
+	var prototypes = map[rune]string{}
+
+	var scanner *bufio.Scanner = bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		var line string = strings.TrimPrefix(scanner.Text(), "\uFEFF")
+
+		if after, found := strings.CutPrefix(line, "# Version: "); found {
+			version = strings.TrimSpace(after)
+		}
+		if index := strings.IndexByte(line, '#'); 0 <= index {
+			line = line[:index]
+		}
+		if "" == strings.TrimSpace(line) {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			log.Fatalf("%s:%d: expected 3 fields, but actually got %d", input, lineNumber, len(fields))
+		}
+
+		source, err := parseCodePoints(fields[0])
+		if nil != err || 1 != len(source) {
+			log.Fatalf("%s:%d: bad source %q: %v", input, lineNumber, fields[0], err)
+		}
+		target, err := parseCodePoints(fields[1])
+		if nil != err || len(target) <= 0 {
+			log.Fatalf("%s:%d: bad target %q: %v", input, lineNumber, fields[1], err)
+		}
+
+		var r rune = source[0]
+		if _, found := prototypes[r]; found {
+			log.Fatalf("%s:%d: duplicate source %04X", input, lineNumber, r)
+		}
+
+		// The skeleton algorithm only looks up code points that are their own NFD.
+		if !norm.NFD.IsNormalString(string(r)) {
+			continue
+		}
+
+		prototypes[r] = norm.NFD.String(string(target))
+	}
+	if err := scanner.Err(); nil != err {
+		log.Fatal(err)
+	}
+	if "" == version {
+		log.Fatalf("%s: no version", input)
+	}
+
+	var runes []rune
+	for r := range prototypes {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "// Code generated by gen_confusables.go from %s; DO NOT EDIT.\n\n", input)
+	fmt.Fprintf(&buffer, "package blanknode\n\n")
+	fmt.Fprintf(&buffer, "// confusablesVersion is the version of the Unicode confusables data in confusablePrototypes.\n")
+	fmt.Fprintf(&buffer, "const confusablesVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// confusablePrototypes maps each code point (that is its own NFD) in the Unicode confusables data to the NFD of its prototype.\n")
+	fmt.Fprintf(&buffer, "var confusablePrototypes = map[rune]string{\n")
+	for _, r := range runes {
+		fmt.Fprintf(&buffer, "0x%04X: %s,\n", r, strconv.QuoteToASCII(prototypes[r]))
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	formatted, err := format.Source(buffer.Bytes())
+	if nil != err {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, formatted, 0644); nil != err {
+		log.Fatal(err)
+	}
+}
+
+func parseCodePoints(field string) ([]rune, error) {
+	var runes []rune
+	for _, hex := range strings.Fields(field) {
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if nil != err {
+			return nil, err
+		}
+		if 0x10FFFF < n {
+			return nil, fmt.Errorf("code point %s out of range", hex)
+		}
+		runes = append(runes, rune(n))
+	}
+	return runes, nil
+}
@@ -0,0 +1,275 @@
+//go:build ignore
+
+// gen_scriptextensions generates scriptextensions_table.go from the Unicode Character Database files
+// testdata/unicode/Scripts.txt and testdata/unicode/ScriptExtensions.txt
+// (with testdata/unicode/PropertyValueAliases.txt for the names of the scripts).
+//
+// It is run by "go generate".
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	aliasesInput    = "testdata/unicode/PropertyValueAliases.txt"
+	scriptsInput    = "testdata/unicode/Scripts.txt"
+	extensionsInput = "testdata/unicode/ScriptExtensions.txt"
+	output          = "scriptextensions_table.go"
+)
+
+// augmentations are from UTS #39 (https://www.unicode.org/reports/tr39/#Mixed_Script_Detection):
+// Han, Hiragana, Katakana, Hangul, and Bopomofo also get the scripts for how they are written together.
+var augmentations = map[string][]string{
+	"Bopo": {"Hanb"},
+	"Hang": {"Kore"},
+	"Hani": {"Hanb", "Jpan", "Kore"},
+	"Hira": {"Jpan"},
+	"Kana": {"Jpan"},
+}
+
+type scriptRange struct {
+	low     rune
+	high    rune
+	scripts []string
+}
+
+func main() {
+	// Scripts.txt uses the long names of scripts (for example, "Latin"), and ScriptExtensions.txt the short ones (for example, "Latn").
+	var shortNames = map[string]string{}
+	aliasesVersion := readUCD(aliasesInput, func(fields []string) {
+		if "sc" != fields[0] || len(fields) < 3 {
+			return
+		}
+		for _, alias := range fields[2:] {
+			shortNames[alias] = fields[1]
+		}
+	})
+
+	// The Script_Extensions of a code point is its Script, unless ScriptExtensions.txt says otherwise.
+	// A code point in neither is Unknown ("Zzzz"), and is not in the table.
+	var scripts = map[rune][]string{}
+	scriptsVersion := readUCD(scriptsInput, func(fields []string) {
+		short, found := shortNames[fields[1]]
+		if !found {
+			log.Fatalf("%s: unknown script %q", scriptsInput, fields[1])
+		}
+		low, high := parseRange(scriptsInput, fields[0])
+		for r := low; r <= high; r++ {
+			scripts[r] = []string{short}
+		}
+	})
+	extensionsVersion := readUCD(extensionsInput, func(fields []string) {
+		low, high := parseRange(extensionsInput, fields[0])
+		for r := low; r <= high; r++ {
+			if _, found := scripts[r]; !found {
+				log.Fatalf("%s: %04X is not in %s", extensionsInput, r, scriptsInput)
+			}
+			scripts[r] = strings.Fields(fields[1])
+		}
+	})
+
+	if scriptsVersion != extensionsVersion || scriptsVersion != aliasesVersion {
+		log.Fatalf("different versions: %s is %s, %s is %s, and %s is %s", scriptsInput, scriptsVersion, extensionsInput, extensionsVersion, aliasesInput, aliasesVersion)
+	}
+	var version string = scriptsVersion
+
+	var ranges []scriptRange
+	var names = map[string]struct{}{
+		"Zzzz": {},
+	}
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		list, found := scripts[r]
+		if !found {
+			continue
+		}
+
+		for _, script := range list {
+			if ("Zyyy" == script || "Zinh" == script) && 1 != len(list) {
+				log.Fatalf("%04X: %s with other scripts", r, script)
+			}
+			for _, augmentation := range augmentations[script] {
+				if !slices.Contains(list, augmentation) {
+					list = append(list, augmentation)
+				}
+			}
+		}
+		for _, script := range list {
+			if "Zyyy" != script && "Zinh" != script {
+				names[script] = struct{}{}
+			}
+		}
+
+		if last := len(ranges) - 1; 0 <= last && ranges[last].high == r-1 && slices.Equal(ranges[last].scripts, list) {
+			ranges[last].high = r
+			continue
+		}
+		ranges = append(ranges, scriptRange{low: r, high: r, scripts: list})
+	}
+
+	var sortedNames []string
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	slices.Sort(sortedNames)
+
+	var words int = (len(sortedNames) + 63) / 64
+
+	// Set 0 is all scripts — for Common ("Zyyy") and Inherited ("Zinh").
+	var sets []string
+	var setIndexes = map[string]int{}
+	{
+		var all []uint64 = make([]uint64, words)
+		for bit := range sortedNames {
+			all[bit/64] |= 1 << (bit % 64)
+		}
+		sets = append(sets, formatSet(all))
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "// Code generated by gen_scriptextensions.go from %s and %s; DO NOT EDIT.\n\n", scriptsInput, extensionsInput)
+	fmt.Fprintf(&buffer, "package blanknode\n\n")
+	fmt.Fprintf(&buffer, "// scriptExtensionsVersion is the version of the Unicode Script_Extensions data in scriptExtensionRanges.\n")
+	fmt.Fprintf(&buffer, "const scriptExtensionsVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// scriptSetWords is how many uint64 a scriptSet needs, for a bit for each of scriptNames.\n")
+	fmt.Fprintf(&buffer, "const scriptSetWords = %d\n\n", words)
+	fmt.Fprintf(&buffer, "// scriptUnknown is the bit (in a scriptSet) for Unknown (\"Zzzz\") — i.e., an unassigned code point.\n")
+	fmt.Fprintf(&buffer, "const scriptUnknown = %d\n\n", slices.Index(sortedNames, "Zzzz"))
+	fmt.Fprintf(&buffer, "// scriptNames are the ISO 15924 names of the scripts, in the order of their bits in a scriptSet.\n")
+	fmt.Fprintf(&buffer, "var scriptNames = [...]string{\n")
+	for _, name := range sortedNames {
+		fmt.Fprintf(&buffer, "%q,\n", name)
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+
+	var body bytes.Buffer
+	for _, r := range ranges {
+		var index int
+		if "Zyyy" != r.scripts[0] && "Zinh" != r.scripts[0] {
+			var set []uint64 = make([]uint64, words)
+			for _, script := range r.scripts {
+				var bit int = slices.Index(sortedNames, script)
+				set[bit/64] |= 1 << (bit % 64)
+			}
+
+			var formatted string = formatSet(set)
+			var found bool
+			index, found = setIndexes[formatted]
+			if !found {
+				index = len(sets)
+				setIndexes[formatted] = index
+				sets = append(sets, formatted)
+			}
+		}
+		fmt.Fprintf(&body, "{0x%04X, 0x%04X, %d}, // %s\n", r.low, r.high, index, strings.Join(r.scripts, " "))
+	}
+
+	fmt.Fprintf(&buffer, "// scriptSets are the (augmented) Script_Extensions sets, indexed by scriptExtensionRange.set.\n")
+	fmt.Fprintf(&buffer, "// Set 0 is all scripts — for Common and Inherited.\n")
+	fmt.Fprintf(&buffer, "var scriptSets = [...]scriptSet{\n")
+	for _, set := range sets {
+		fmt.Fprintf(&buffer, "%s,\n", set)
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+
+	fmt.Fprintf(&buffer, "// scriptExtensionRanges are the (augmented) Script_Extensions of every assigned code point, sorted.\n")
+	fmt.Fprintf(&buffer, "var scriptExtensionRanges = [...]scriptExtensionRange{\n")
+	buffer.Write(body.Bytes())
+	fmt.Fprintf(&buffer, "}\n")
+
+	formatted, err := format.Source(buffer.Bytes())
+	if nil != err {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, formatted, 0644); nil != err {
+		log.Fatal(err)
+	}
+}
+
+func formatSet(set []uint64) string {
+	var parts []string
+	for _, word := range set {
+		parts = append(parts, fmt.Sprintf("0x%016X", word))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// readUCD calls ‘fn’ with the fields of each line of ‘name’ (a file in the format of the Unicode Character Database), and returns its version.
+//
+// The version is from the first line — for example, "# Scripts-15.0.0.txt".
+func readUCD(name string, fn func(fields []string)) string {
+	file, err := os.Open(name)
+	if nil != err {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var version string
+	var scanner *bufio.Scanner = bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		var line string = strings.TrimPrefix(scanner.Text(), "\uFEFF")
+
+		if 1 == lineNumber {
+			if match := ucdVersion.FindStringSubmatch(line); nil != match {
+				version = match[1]
+			}
+		}
+		if index := strings.IndexByte(line, '#'); 0 <= index {
+			line = line[:index]
+		}
+		if "" == strings.TrimSpace(line) {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			log.Fatalf("%s:%d: expected at least 2 fields", name, lineNumber)
+		}
+		for index := range fields {
+			fields[index] = strings.TrimSpace(fields[index])
+		}
+
+		fn(fields)
+	}
+	if err := scanner.Err(); nil != err {
+		log.Fatal(err)
+	}
+	if "" == version {
+		log.Fatalf("%s: no version", name)
+	}
+
+	return version
+}
+
+var ucdVersion = regexp.MustCompile(`^#\s*[A-Za-z]+-([0-9]+\.[0-9]+\.[0-9]+)\.txt`)
+
+// parseRange parses a code point (for example, "0041") or a range of them (for example, "0041..005A").
+func parseRange(name string, field string) (rune, rune) {
+	lowHex, highHex, found := strings.Cut(field, "..")
+	if !found {
+		highHex = lowHex
+	}
+	low, err := strconv.ParseUint(lowHex, 16, 32)
+	if nil != err {
+		log.Fatalf("%s: %v", name, err)
+	}
+	high, err := strconv.ParseUint(highHex, 16, 32)
+	if nil != err {
+		log.Fatalf("%s: %v", name, err)
+	}
+	if high < low || unicode.MaxRune < high {
+		log.Fatalf("%s: bad range %q", name, field)
+	}
+
+	return rune(low), rune(high)
+}
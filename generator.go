@@ -0,0 +1,285 @@
+package blanknode
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// DefaultGeneratorPrefix is the prefix a [Generator] uses if it is not given one.
+const DefaultGeneratorPrefix string = "b"
+
+const generatorStateVersion byte = 1
+
+var (
+	_ encoding.BinaryMarshaler   = &Generator{}
+	_ encoding.BinaryUnmarshaler = &Generator{}
+)
+
+// Generator generates new blank-node-identifiers — a prefix followed by a counter.
+// For example:
+//
+//	_:b0
+//	_:b1
+//	_:b2
+//
+// The zero value is ready to use, and uses the prefix "b".
+// It is safe to use from multiple goroutines.
+//
+// The state of a Generator can be checkpointed with [Generator.MarshalBinary] and restored with [Generator.UnmarshalBinary],
+// so that it keeps generating blank-node-identifiers after the last one it generated (rather than starting over at "_:b0").
+//
+// For a Generator that never generates the same blank-node-identifier twice — even after a crash — see [OpenGenerator].
+type Generator struct {
+	mutex  sync.Mutex
+	prefix string
+	next   uint64
+
+	// For a Generator returned by OpenGenerator.
+	path      string
+	blockSize uint64
+	reserved  uint64
+}
+
+// NewGenerator returns a new [Generator] that generates blank-node-identifiers with the prefix ‘prefix’.
+//
+// ‘prefix’ must be something that a blank-node-label can begin with.
+func NewGenerator(prefix string) (*Generator, error) {
+	if err := validateGeneratorPrefix(prefix); nil != err {
+		return nil, err
+	}
+
+	return &Generator{
+		prefix: prefix,
+	}, nil
+}
+
+// OpenGenerator returns a file-backed [Generator].
+//
+// A file-backed Generator reserves the counter values in blocks of ‘blockSize’,
+// by writing the end of the block to the file at ‘path’ (and calling fsync) before generating any blank-node-identifiers from the block.
+// If the file already exists, the Generator continues after the last block that was reserved.
+// So a blank-node-identifier is never generated twice — even if the program crashes.
+// (After a crash, the rest of the last block is skipped.)
+//
+// The file is not locked.
+// So only one Generator may use a file at a time — i.e., one process, calling OpenGenerator once.
+// Two Generators using the same file (whether in different processes or in the same one) would reserve the same blocks,
+// and generate the same blank-node-identifiers.
+//
+// If the file does not exist, then it is created, and the Generator uses the prefix ‘prefix’.
+// If the file does exist, then the Generator uses the prefix in the file — and it is an error if that is not ‘prefix’.
+//
+// For example:
+//
+//	generator, err := blanknode.OpenGenerator("ingest.generator", "b", 1000)
+func OpenGenerator(path string, prefix string, blockSize uint64) (*Generator, error) {
+	if err := validateGeneratorPrefix(prefix); nil != err {
+		return nil, err
+	}
+	if "" == prefix {
+		prefix = DefaultGeneratorPrefix
+	}
+	if blockSize < 1 {
+		blockSize = 1
+	}
+
+	var generator Generator = Generator{
+		prefix:    prefix,
+		path:      path,
+		blockSize: blockSize,
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// nothing here.
+	case nil != err:
+		return nil, erorr.Errorf("generator: %w", err)
+	default:
+		var stored Generator
+		if err := stored.UnmarshalBinary(data); nil != err {
+			return nil, erorr.Errorf("generator file %q: %w", path, err)
+		}
+		if stored.prefix != prefix {
+			return nil, erorr.Errorf("generator file %q has prefix %q rather than %q: %w", path, stored.prefix, prefix, ErrGeneratorStateMalformed)
+		}
+
+		generator.next = stored.next
+		generator.reserved = stored.next
+	}
+
+	return &generator, nil
+}
+
+// Identifier returns a new blank-node-identifier.
+//
+// An error is only possible with a file-backed [Generator] (see [OpenGenerator]), if it could not reserve a block.
+func (receiver *Generator) Identifier() (Identifier, error) {
+	label, err := receiver.Label()
+	if nil != err {
+		return Identifier{}, err
+	}
+
+	return someIdentifier(label), nil
+}
+
+// Label returns a new blank-node-label.
+//
+// An error is only possible with a file-backed [Generator] (see [OpenGenerator]), if it could not reserve a block.
+func (receiver *Generator) Label() (Label, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if "" != receiver.path && receiver.reserved <= receiver.next {
+		if err := receiver.reserve(receiver.next + receiver.blockSize); nil != err {
+			return Label{}, err
+		}
+	}
+
+	var n uint64 = receiver.next
+	receiver.next++
+
+	return someLabel(receiver.prefixOrDefault() + strconv.FormatUint(n, 10)), nil
+}
+
+// MarshalBinary makes [Generator] fit [encoding.BinaryMarshaler].
+//
+// It returns a checkpoint of the state of the [Generator] — its prefix, and the counter value of the next blank-node-identifier it would generate.
+func (receiver *Generator) MarshalBinary() ([]byte, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return receiver.marshal(receiver.next), nil
+}
+
+// UnmarshalBinary makes [Generator] fit [encoding.BinaryUnmarshaler].
+//
+// It restores a checkpoint returned by [Generator.MarshalBinary].
+// (For a file-backed [Generator], the state in the file is not changed until the next block is reserved.)
+//
+// A file-backed Generator keeps the prefix in its file,
+// so it returns an error that wraps [ErrGeneratorStateMalformed] if the checkpoint has a different prefix.
+func (receiver *Generator) UnmarshalBinary(data []byte) error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	if len(data) < 1 || generatorStateVersion != data[0] {
+		return erorr.Errorf("generator state: unknown version: %w", ErrGeneratorStateMalformed)
+	}
+	data = data[1:]
+
+	next, n := binary.Uvarint(data)
+	if n <= 0 {
+		return erorr.Errorf("generator state: bad counter: %w", ErrGeneratorStateMalformed)
+	}
+	data = data[n:]
+
+	var prefix string = string(data)
+	if err := validateGeneratorPrefix(prefix); nil != err {
+		return erorr.Errorf("generator state: %w", err)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if "" != receiver.path && receiver.prefixOrDefault() != prefixOrDefault(prefix) {
+		return erorr.Errorf("generator state has prefix %q rather than %q (the prefix in the file %q): %w", prefixOrDefault(prefix), receiver.prefixOrDefault(), receiver.path, ErrGeneratorStateMalformed)
+	}
+
+	// A file-backed Generator never goes backwards, since that could generate a blank-node-identifier twice.
+	if "" != receiver.path && next < receiver.reserved {
+		next = receiver.reserved
+	}
+
+	receiver.prefix = prefix
+	receiver.next = next
+	receiver.reserved = next
+
+	return nil
+}
+
+func (receiver *Generator) marshal(next uint64) []byte {
+	var data []byte = []byte{generatorStateVersion}
+	data = binary.AppendUvarint(data, next)
+	data = append(data, receiver.prefixOrDefault()...)
+
+	return data
+}
+
+func (receiver *Generator) prefixOrDefault() string {
+	return prefixOrDefault(receiver.prefix)
+}
+
+func prefixOrDefault(prefix string) string {
+	if "" == prefix {
+		return DefaultGeneratorPrefix
+	}
+
+	return prefix
+}
+
+// reserve writes ‘reserved’ to the file (atomically, and durably) so that counter values before it are never generated again.
+func (receiver *Generator) reserve(reserved uint64) error {
+	var directory string = filepath.Dir(receiver.path)
+
+	temporary, err := os.CreateTemp(directory, filepath.Base(receiver.path)+".*")
+	if nil != err {
+		return erorr.Errorf("generator: %w", err)
+	}
+	defer os.Remove(temporary.Name())
+
+	if _, err := temporary.Write(receiver.marshal(reserved)); nil != err {
+		temporary.Close()
+		return erorr.Errorf("generator: %w", err)
+	}
+	if err := temporary.Sync(); nil != err {
+		temporary.Close()
+		return erorr.Errorf("generator: %w", err)
+	}
+	if err := temporary.Close(); nil != err {
+		return erorr.Errorf("generator: %w", err)
+	}
+
+	if err := os.Rename(temporary.Name(), receiver.path); nil != err {
+		return erorr.Errorf("generator: %w", err)
+	}
+
+	// fsync the directory too, so that the rename is durable.
+	if err := syncDirectory(directory); nil != err {
+		return erorr.Errorf("generator: %w", err)
+	}
+
+	receiver.reserved = reserved
+	return nil
+}
+
+// validateGeneratorPrefix returns an error if blank-node-labels beginning with ‘prefix’ (and followed by digits) would not be valid.
+// An empty prefix is OK — it means [DefaultGeneratorPrefix].
+func validateGeneratorPrefix(prefix string) error {
+	if "" == prefix {
+		return nil
+	}
+
+	if _, err := ParseLabelString(prefix + "0"); nil != err {
+		return erorr.Errorf("generator prefix %q: %w", prefix, err)
+	}
+
+	return nil
+}
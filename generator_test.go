@@ -0,0 +1,265 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"path/filepath"
+)
+
+func generatorTestIdentifiers(t *testing.T, generator *Generator, count int) []string {
+	t.Helper()
+
+	var result []string
+	for i := 0; i < count; i++ {
+		identifier, err := generator.Identifier()
+		if nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+		result = append(result, identifier.String())
+	}
+
+	return result
+}
+
+func generatorTestEqual(t *testing.T, expected []string, actual []string) {
+	t.Helper()
+
+	if len(expected) != len(actual) {
+		t.Errorf("The actual blank-node-identifiers are not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+		return
+	}
+	for index := range expected {
+		if expected[index] != actual[index] {
+			t.Errorf("The actual blank-node-identifiers are not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			return
+		}
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	var generator Generator
+
+	generatorTestEqual(t, []string{"_:b0", "_:b1", "_:b2"}, generatorTestIdentifiers(t, &generator, 3))
+}
+
+func TestNewGenerator(t *testing.T) {
+	generator, err := NewGenerator("node")
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	generatorTestEqual(t, []string{"_:node0", "_:node1"}, generatorTestIdentifiers(t, generator, 2))
+}
+
+func TestNewGenerator_error(t *testing.T) {
+	tests := []struct{
+		Prefix string
+	}{
+		{
+			Prefix: "-b",
+		},
+		{
+			Prefix: "b c",
+		},
+		{
+			Prefix: "b:",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := NewGenerator(test.Prefix)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("PREFIX: %q", test.Prefix)
+			continue
+		}
+	}
+}
+
+func TestGenerator_MarshalBinary(t *testing.T) {
+	generator, err := NewGenerator("n")
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	generatorTestIdentifiers(t, generator, 300)
+
+	checkpoint, err := generator.MarshalBinary()
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	// Version 1, uvarint(300), "n".
+	if expected, actual := "\x01\xac\x02n", string(checkpoint); expected != actual {
+		t.Errorf("The actual checkpoint is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+		return
+	}
+
+	var restored Generator
+	if err := restored.UnmarshalBinary(checkpoint); nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	generatorTestEqual(t, []string{"_:n300", "_:n301"}, generatorTestIdentifiers(t, &restored, 2))
+}
+
+func TestGenerator_UnmarshalBinary_error(t *testing.T) {
+	tests := []struct{
+		Data []byte
+	}{
+		{
+			Data: nil,
+		},
+		{
+			Data: []byte("\x02\x00b"),
+		},
+		{
+			Data: []byte("\x01\x80"),
+		},
+		{
+			Data: []byte("\x01\x00-"),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var generator Generator
+		err := generator.UnmarshalBinary(test.Data)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("DATA: %q", test.Data)
+			continue
+		}
+	}
+}
+
+func TestGenerator_nilReceiver(t *testing.T) {
+	var generator *Generator
+
+	for name, fn := range map[string]func(){
+		"Identifier":      func() { generator.Identifier() },
+		"Label":           func() { generator.Label() },
+		"MarshalBinary":   func() { generator.MarshalBinary() },
+		"UnmarshalBinary": func() { generator.UnmarshalBinary([]byte("\x01\x00b")) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); ErrNilReceiver != r {
+					t.Errorf("The actual panic from %s is not what was expected.", name)
+					t.Logf("EXPECTED: %v", ErrNilReceiver)
+					t.Logf("ACTUAL:   %v", r)
+				}
+			}()
+
+			fn()
+		}()
+	}
+}
+
+func TestOpenGenerator(t *testing.T) {
+	var path string = filepath.Join(t.TempDir(), "ingest.generator")
+
+	first, err := OpenGenerator(path, "b", 10)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	generatorTestEqual(t, []string{"_:b0", "_:b1", "_:b2"}, generatorTestIdentifiers(t, first, 3))
+
+	// A "crash" — the first Generator is just abandoned.
+	// The rest of its block (_:b3 to _:b9) is skipped.
+	second, err := OpenGenerator(path, "b", 10)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	generatorTestEqual(t, []string{"_:b10", "_:b11"}, generatorTestIdentifiers(t, second, 2))
+
+	// Going past the end of a block reserves the next block.
+	generatorTestIdentifiers(t, second, 8)
+	generatorTestEqual(t, []string{"_:b20"}, generatorTestIdentifiers(t, second, 1))
+
+	third, err := OpenGenerator(path, "b", 10)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	generatorTestEqual(t, []string{"_:b30"}, generatorTestIdentifiers(t, third, 1))
+
+	if _, err := OpenGenerator(path, "x", 10); !errors.Is(err, ErrGeneratorStateMalformed) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrGeneratorStateMalformed)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func TestOpenGenerator_UnmarshalBinary(t *testing.T) {
+	var path string = filepath.Join(t.TempDir(), "ingest.generator")
+
+	generator, err := OpenGenerator(path, "b", 10)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	generatorTestEqual(t, []string{"_:b0", "_:b1"}, generatorTestIdentifiers(t, generator, 2))
+
+	{
+		other, err := NewGenerator("x")
+		if nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+		generatorTestIdentifiers(t, other, 50)
+
+		data, err := other.MarshalBinary()
+		if nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+
+		if err := generator.UnmarshalBinary(data); !errors.Is(err, ErrGeneratorStateMalformed) {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED: %s", ErrGeneratorStateMalformed)
+			t.Logf("ACTUAL:   %v", err)
+		}
+
+		// The Generator is not changed.
+		generatorTestEqual(t, []string{"_:b2"}, generatorTestIdentifiers(t, generator, 1))
+	}
+
+	{
+		var other Generator
+		generatorTestIdentifiers(t, &other, 50)
+
+		data, err := other.MarshalBinary()
+		if nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+
+		if err := generator.UnmarshalBinary(data); nil != err {
+			t.Errorf("Did not expect an error but actually got one.")
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+
+		generatorTestEqual(t, []string{"_:b50"}, generatorTestIdentifiers(t, generator, 1))
+	}
+}
@@ -5,6 +5,8 @@ go 1.25.4
 require (
 	codeberg.org/reiver/go-erorr v0.0.0-20260103001947-b254c409f0ce
 	github.com/reiver/go-opt v0.0.0-20240809035328-1ff08dec9bc4
+	github.com/reiver/go-ord v0.0.0-20260222220705-d6aedb3eb0fc
+	golang.org/x/text v0.40.0
 )
 
 require (
@@ -13,5 +15,4 @@ require (
 	github.com/reiver/go-erorr v0.0.0-20240801233437-8cbde6d1fa3f // indirect
 	github.com/reiver/go-json v0.0.0-20240809035039-2f83bc2e8c10 // indirect
 	github.com/reiver/go-lck v0.0.0-20240808133902-b56df221c39f // indirect
-	github.com/reiver/go-ord v0.0.0-20260222220705-d6aedb3eb0fc // indirect
 )
@@ -0,0 +1,222 @@
+package blanknode
+
+import (
+	"bytes"
+	"encoding/base32"
+	"hash"
+	"sync"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// HashLabelPrefix is the prefix at the beginning of all blank-node-labels returned by [HashLabel] and [HashLabelBits].
+//
+// A base32 digest can begin with a digit, which is allowed in a blank-node-label, but the prefix makes it obvious which blank-node-labels are content-addressed.
+const HashLabelPrefix string = "h"
+
+var hashLabelEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// HashLabel returns a content-addressed blank-node-label.
+//
+// The blank-node-label is "h" followed by the lowercase base32 (without padding) of the digest of ‘data’.
+// For example, the SHA-256 of "apple" gives:
+//
+//	hhj55hyrwbi6st3veg36pw7sey425cf6efuobqnkcbnvzsqw5j4nq
+//
+// ‘h’ is reset before ‘data’ is written to it.
+// The ‘data’ is written to ‘h’ one after the other, so this is the same as the digest of all of ‘data’ concatenated together.
+//
+// Calling HashLabel with the same kind of hash, and the same data, always returns the same blank-node-label.
+// Which makes it useful for idempotent imports.
+//
+// The returned blank-node-label is always valid under [ParseLabelString].
+//
+// HashLabel panics with [ErrNilHash] if ‘h’ is nil.
+//
+// See also: [HashLabelBits], [HashIdentifier], [HashLabeler].
+func HashLabel(h hash.Hash, data ...[]byte) Label {
+	return HashLabelBits(h, 0, data...)
+}
+
+// HashLabelBits is like [HashLabel] except that the digest is truncated to (at least) ‘bits’ bits.
+//
+// Each base32 character holds 5 bits, so ‘bits’ is rounded up to a multiple of 5.
+// If ‘bits’ is zero, negative, or larger than the size of the digest, then the whole digest is used.
+//
+// For example, HashLabelBits with ‘bits’ of 64 returns "h" followed by 13 base32 characters.
+//
+// Truncating the digest makes the blank-node-label shorter, but also makes collisions more likely.
+// See [HashLabeler] for collision detection.
+//
+// HashLabelBits panics with [ErrNilHash] if ‘h’ is nil.
+func HashLabelBits(h hash.Hash, bits int, data ...[]byte) Label {
+	if nil == h {
+		panic(ErrNilHash)
+	}
+
+	return someLabel(hashLabelString(hashDigest(h, data...), bits))
+}
+
+// HashIdentifier is like [HashLabel] except that it returns a blank-node-identifier.
+//
+// For example, the SHA-256 of "apple" gives:
+//
+//	_:hhj55hyrwbi6st3veg36pw7sey425cf6efuobqnkcbnvzsqw5j4nq
+func HashIdentifier(h hash.Hash, data ...[]byte) Identifier {
+	return someIdentifier(HashLabel(h, data...))
+}
+
+// HashIdentifierBits is like [HashLabelBits] except that it returns a blank-node-identifier.
+func HashIdentifierBits(h hash.Hash, bits int, data ...[]byte) Identifier {
+	return someIdentifier(HashLabelBits(h, bits, data...))
+}
+
+func hashDigest(h hash.Hash, data ...[]byte) []byte {
+	h.Reset()
+	for _, datum := range data {
+		h.Write(datum)
+	}
+
+	return h.Sum(nil)
+}
+
+func hashLabelString(digest []byte, bits int) string {
+	var encoded string = hashLabelEncoding.EncodeToString(digest)
+
+	if 0 < bits && bits < len(digest)*8 {
+		var length int = (bits + 4) / 5
+		if length < len(encoded) {
+			encoded = encoded[:length]
+		}
+	}
+
+	return HashLabelPrefix + encoded
+}
+
+// HashLabeler returns content-addressed blank-node-labels (like [HashLabelBits] does), and also detects collisions.
+//
+// A collision is when two different digests result in the same blank-node-label.
+// (Which can happen when the digest is truncated with ‘Bits’.)
+// To detect collisions, HashLabeler remembers the full digest of each blank-node-label it has returned.
+//
+// For example:
+//
+//	var labeler blanknode.HashLabeler = blanknode.HashLabeler{
+//		Hash: sha256.New,
+//		Bits: 64,
+//	}
+//
+//	// ...
+//
+//	label, err := labeler.Label(data)
+//	if nil != err {
+//		return err
+//	}
+//
+// The remembered digests are never forgotten on their own, so the memory a HashLabeler uses grows with the number of different blank-node-labels it has returned.
+// To forget them (for example, between documents), see [HashLabeler.Reset].
+//
+// HashLabeler is safe to use from multiple goroutines.
+// A HashLabeler must not be copied after first use.
+type HashLabeler struct {
+	// Hash returns the hash used to calculate the digest.
+	Hash func() hash.Hash
+
+	// Bits is the number of bits of the digest to use.
+	// See [HashLabelBits].
+	Bits int
+
+	// OnCollision, if not nil, is called when a collision is detected.
+	// ‘previous’ is the full digest that was remembered for ‘label’, and ‘digest’ is the new full digest.
+	//
+	// If OnCollision returns nil, then ‘label’ is returned (even though it collides).
+	// If OnCollision returns an error, then that error is returned.
+	//
+	// If OnCollision is nil, then an error that wraps [ErrHashCollision] is returned.
+	//
+	// OnCollision is called without the HashLabeler locked, so it can call the HashLabeler's methods.
+	// (It can also be called from more than one goroutine at a time.)
+	OnCollision func(label Label, previous []byte, digest []byte) error
+
+	mutex   sync.Mutex
+	digests map[string][]byte
+}
+
+// Label returns the content-addressed blank-node-label for ‘data’.
+//
+// It returns an error that wraps [ErrHashCollision] if a collision is detected (and ‘OnCollision’ is nil),
+// and [ErrNilHash] if ‘Hash’ is nil (or returns nil).
+func (receiver *HashLabeler) Label(data ...[]byte) (Label, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+	if nil == receiver.Hash {
+		return Label{}, ErrNilHash
+	}
+
+	var h hash.Hash = receiver.Hash()
+	if nil == h {
+		return Label{}, ErrNilHash
+	}
+
+	var digest []byte = hashDigest(h, data...)
+	var str string = hashLabelString(digest, receiver.Bits)
+
+	var previous []byte
+	{
+		receiver.mutex.Lock()
+
+		if nil == receiver.digests {
+			receiver.digests = map[string][]byte{}
+		}
+
+		var found bool
+		previous, found = receiver.digests[str]
+		if !found {
+			receiver.digests[str] = digest
+		}
+
+		receiver.mutex.Unlock()
+
+		if !found || bytes.Equal(previous, digest) {
+			return someLabel(str), nil
+		}
+	}
+
+	var label Label = someLabel(str)
+
+	// The mutex is not held here, so OnCollision can use the HashLabeler.
+	fn := receiver.OnCollision
+	if nil == fn {
+		return Label{}, erorr.Errorf("blank-node-label %q collides for digests %x and %x: %w", str, previous, digest, ErrHashCollision)
+	}
+	if err := fn(label, previous, digest); nil != err {
+		return Label{}, err
+	}
+
+	return label, nil
+}
+
+// Reset forgets the digests the [HashLabeler] remembers, freeing their memory.
+//
+// After Reset, collisions with the blank-node-labels returned before it are not detected.
+func (receiver *HashLabeler) Reset() {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.digests = nil
+}
+
+// Identifier is like [HashLabeler.Label] except that it returns a blank-node-identifier.
+func (receiver *HashLabeler) Identifier(data ...[]byte) (Identifier, error) {
+	label, err := receiver.Label(data...)
+	if nil != err {
+		return Identifier{}, err
+	}
+
+	return someIdentifier(label), nil
+}
@@ -0,0 +1,321 @@
+package blanknode
+
+import (
+	"testing"
+
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"time"
+)
+
+func TestHashLabelBits(t *testing.T) {
+	tests := []struct {
+		Data          [][]byte
+		Bits          int
+		ExpectedLabel Label
+	}{
+		{
+			ExpectedLabel: someLabel("h4oymiquy7qobjgx36tejs35zeqt24qpemsnzgtfeswmrw6csxbkq"),
+		},
+
+
+
+		{
+			Data:          [][]byte{[]byte("apple")},
+			ExpectedLabel: someLabel("hhj55hyrwbi6st3veg36pw7sey425cf6efuobqnkcbnvzsqw5j4nq"),
+		},
+		{
+			Data:          [][]byte{[]byte("ap"), []byte("ple")},
+			ExpectedLabel: someLabel("hhj55hyrwbi6st3veg36pw7sey425cf6efuobqnkcbnvzsqw5j4nq"),
+		},
+		{
+			Data:          [][]byte{[]byte("apple")},
+			Bits:          -1,
+			ExpectedLabel: someLabel("hhj55hyrwbi6st3veg36pw7sey425cf6efuobqnkcbnvzsqw5j4nq"),
+		},
+		{
+			Data:          [][]byte{[]byte("apple")},
+			Bits:          256,
+			ExpectedLabel: someLabel("hhj55hyrwbi6st3veg36pw7sey425cf6efuobqnkcbnvzsqw5j4nq"),
+		},
+		{
+			Data:          [][]byte{[]byte("apple")},
+			Bits:          64,
+			ExpectedLabel: someLabel("hhj55hyrwbi6st"),
+		},
+		{
+			Data:          [][]byte{[]byte("apple")},
+			Bits:          65,
+			ExpectedLabel: someLabel("hhj55hyrwbi6st"),
+		},
+		{
+			Data:          [][]byte{[]byte("apple")},
+			Bits:          66,
+			ExpectedLabel: someLabel("hhj55hyrwbi6st3"),
+		},
+		{
+			Data:          [][]byte{[]byte("apple")},
+			Bits:          1,
+			ExpectedLabel: someLabel("hh"),
+		},
+	}
+
+	for testNumber, test := range tests {
+		actualLabel := HashLabelBits(sha256.New(), test.Bits, test.Data...)
+
+		{
+			expected := test.ExpectedLabel
+			actual := actualLabel
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual blank-node-label is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("BITS: %d", test.Bits)
+				continue
+			}
+		}
+
+		{
+			_, err := ParseLabelString(actualLabel.String())
+			if nil != err {
+				t.Errorf("For test #%d, did not expect an error when parsing the blank-node-label, but actually got one.", testNumber)
+				t.Logf("ERROR: %s", err)
+				t.Logf("LABEL: %q", actualLabel)
+				continue
+			}
+		}
+	}
+}
+
+// There are 33 values here, and only 32 blank-node-labels when ‘Bits’ is 5 (or less), so there must be at least one collision.
+var hashLabelerTestValues = []string{
+	"apple", "banana", "cherry", "date", "elderberry", "fig", "grape", "honeydew", "kiwi", "lemon", "mango", "nectarine", "orange", "papaya", "quince", "raspberry", "strawberry", "tangerine", "ugli", "vanilla", "watermelon", "ximenia", "yuzu", "zucchini",
+	"apricot", "blueberry", "coconut", "durian", "eggplant", "feijoa", "guava", "huckleberry", "jackfruit",
+}
+
+func TestHashLabeler_collision(t *testing.T) {
+	var labeler HashLabeler = HashLabeler{
+		Hash: sha256.New,
+		Bits: 5,
+	}
+
+	var seen map[Label]string = map[Label]string{}
+
+	var collided bool
+	for _, value := range hashLabelerTestValues {
+		label, err := labeler.Label([]byte(value))
+
+		previous, found := seen[HashLabelBits(sha256.New(), 5, []byte(value))]
+		if found {
+			if !errors.Is(err, ErrHashCollision) {
+				t.Errorf("Expected a collision between %q and %q, but did not get one.", previous, value)
+				t.Logf("ERROR: %s", err)
+			}
+			collided = true
+			continue
+		}
+		if nil != err {
+			t.Errorf("For %q, did not expect an error, but actually got one.", value)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+
+		seen[label] = value
+
+		{
+			again, err := labeler.Label([]byte(value))
+			if nil != err {
+				t.Errorf("For %q, did not expect an error the second time, but actually got one.", value)
+				t.Logf("ERROR: %s", err)
+				continue
+			}
+			if label != again {
+				t.Errorf("For %q, expected the same blank-node-label the second time.", value)
+				t.Logf("FIRST:  %q", label)
+				t.Logf("SECOND: %q", again)
+				continue
+			}
+		}
+	}
+
+	if !collided {
+		t.Error("Expected at least one collision.")
+	}
+}
+
+func TestHashLabeler_onCollision(t *testing.T) {
+	var collisions int
+
+	var labeler HashLabeler = HashLabeler{
+		Hash: sha256.New,
+		Bits: 1,
+		OnCollision: func(label Label, previous []byte, digest []byte) error {
+			collisions++
+			return nil
+		},
+	}
+
+	for _, value := range hashLabelerTestValues {
+		_, err := labeler.Label([]byte(value))
+		if nil != err {
+			t.Errorf("For %q, did not expect an error, but actually got one.", value)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+	}
+
+	if collisions <= 0 {
+		t.Error("Expected OnCollision to be called, but it was not.")
+	}
+}
+
+func TestHashLabeler_onCollisionReentrant(t *testing.T) {
+	var labeler HashLabeler
+	labeler = HashLabeler{
+		Hash: sha256.New,
+		Bits: 1,
+		OnCollision: func(label Label, previous []byte, digest []byte) error {
+			// This would deadlock if OnCollision were called with the HashLabeler locked.
+			_, err := labeler.Label([]byte("from OnCollision"))
+			if nil != err && !errors.Is(err, ErrHashCollision) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	var done chan error = make(chan error, 1)
+	go func() {
+		for _, value := range hashLabelerTestValues {
+			if _, err := labeler.Label([]byte(value)); nil != err {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+
+	select {
+	case err := <-done:
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one.")
+			t.Logf("ERROR: %s", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Errorf("Expected OnCollision to be able to call the HashLabeler, but it actually deadlocked.")
+	}
+}
+
+func TestHashLabeler_Reset(t *testing.T) {
+	var labeler HashLabeler = HashLabeler{
+		Hash: sha256.New,
+		Bits: 5,
+	}
+
+	// Find two values that collide.
+	var first, second string
+	{
+		var seen map[Label]string = map[Label]string{}
+		for _, value := range hashLabelerTestValues {
+			var label Label = HashLabelBits(sha256.New(), 5, []byte(value))
+			if previous, found := seen[label]; found {
+				first, second = previous, value
+				break
+			}
+			seen[label] = value
+		}
+		if "" == first {
+			t.Fatal("Expected at least one collision.")
+		}
+	}
+
+	if _, err := labeler.Label([]byte(first)); nil != err {
+		t.Fatalf("For %q, did not expect an error, but actually got one: %s", first, err)
+	}
+	if _, err := labeler.Label([]byte(second)); !errors.Is(err, ErrHashCollision) {
+		t.Errorf("Expected a collision between %q and %q, but did not get one.", first, second)
+		t.Logf("ERROR: %v", err)
+		return
+	}
+
+	labeler.Reset()
+
+	if _, err := labeler.Label([]byte(second)); nil != err {
+		t.Errorf("For %q, did not expect an error after Reset, but actually got one.", second)
+		t.Logf("ERROR: %s", err)
+		return
+	}
+	if _, err := labeler.Label([]byte(first)); !errors.Is(err, ErrHashCollision) {
+		t.Errorf("Expected a collision between %q and %q after Reset, but did not get one.", second, first)
+		t.Logf("ERROR: %v", err)
+		return
+	}
+}
+
+func TestHashLabel_nilHash(t *testing.T) {
+	tests := []struct {
+		Name string
+		Func func()
+	}{
+		{
+			Name: "HashLabel",
+			Func: func() { HashLabel(nil, []byte("apple")) },
+		},
+		{
+			Name: "HashLabelBits",
+			Func: func() { HashLabelBits(nil, 64, []byte("apple")) },
+		},
+		{
+			Name: "HashIdentifier",
+			Func: func() { HashIdentifier(nil, []byte("apple")) },
+		},
+		{
+			Name: "HashIdentifierBits",
+			Func: func() { HashIdentifierBits(nil, 64, []byte("apple")) },
+		},
+	}
+
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if r := recover(); ErrNilHash != r {
+					t.Errorf("For %s, the actual panic is not what was expected.", test.Name)
+					t.Logf("EXPECTED: %v", ErrNilHash)
+					t.Logf("ACTUAL:   %v", r)
+				}
+			}()
+
+			test.Func()
+		}()
+	}
+}
+
+func TestHashLabeler_nilHash(t *testing.T) {
+	tests := []struct {
+		Name string
+		Hash func() hash.Hash
+	}{
+		{
+			Name: "nil Hash",
+			Hash: nil,
+		},
+		{
+			Name: "Hash returns nil",
+			Hash: func() hash.Hash { return nil },
+		},
+	}
+
+	for _, test := range tests {
+		var labeler HashLabeler = HashLabeler{
+			Hash: test.Hash,
+		}
+
+		if _, err := labeler.Label([]byte("apple")); !errors.Is(err, ErrNilHash) {
+			t.Errorf("For %s, the actual error is not what was expected.", test.Name)
+			t.Logf("EXPECTED: %s", ErrNilHash)
+			t.Logf("ACTUAL:   %v", err)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package blanknode
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// homomorphism searches for a mapping of the blank-nodes in ‘source’ to the terms in ‘target’, such that every quad in ‘source’ (with the mapping applied) is in ‘target’.
+//
+// The blank-nodes in ‘target’ are treated as constants — even if they have the same blank-node-labels as blank-nodes in ‘source’.
+//
+// It uses constraint propagation (forward checking) with backtracking, choosing the blank-node with the fewest remaining candidates first.
+// The problem is NP-hard in general, so ‘work’ (if not nil) is a budget on the number of steps; each step decrements it.
+type homomorphism struct {
+	source    []Quad
+	variables []Identifier
+	mentions  map[Identifier][]int
+
+	target []Quad
+	index  [4]map[Term][]int
+
+	work *int
+}
+
+func newHomomorphism(source []Quad, target []Quad, work *int) *homomorphism {
+	var h homomorphism = homomorphism{
+		mentions: map[Identifier][]int{},
+		work:     work,
+	}
+
+	for _, quad := range source {
+		h.source = append(h.source, quad)
+
+		var index int = len(h.source) - 1
+		for _, identifier := range quad.BlankNodes() {
+			if _, found := h.mentions[identifier]; !found {
+				h.variables = append(h.variables, identifier)
+			}
+			h.mentions[identifier] = append(h.mentions[identifier], index)
+		}
+	}
+
+	for position := range h.index {
+		h.index[position] = map[Term][]int{}
+	}
+	{
+		var seen map[Quad]struct{} = map[Quad]struct{}{}
+		for _, quad := range target {
+			if _, found := seen[quad]; found {
+				continue
+			}
+			seen[quad] = struct{}{}
+
+			h.target = append(h.target, quad)
+
+			var index int = len(h.target) - 1
+			for position, term := range quad.terms() {
+				h.index[position][term] = append(h.index[position][term], index)
+			}
+		}
+	}
+
+	return &h
+}
+
+// find returns a mapping, if there is one.
+//
+// It returns false (with a nil error) if there is not one,
+// and an error that wraps [ErrBudgetExceeded] if the budget ran out before it could tell.
+func (receiver *homomorphism) find() (map[Identifier]Term, bool, error) {
+	var domains map[Identifier][]Term = map[Identifier][]Term{}
+
+	for index, quad := range receiver.source {
+		var matches []Quad = receiver.matches(index, nil)
+		if len(matches) <= 0 {
+			return nil, false, nil
+		}
+
+		for _, identifier := range quad.BlankNodes() {
+			var support []Term = supportOf(quad, identifier, matches)
+
+			domain, found := domains[identifier]
+			if found {
+				support = intersectTerms(domain, support)
+			}
+			if len(support) <= 0 {
+				return nil, false, nil
+			}
+			domains[identifier] = support
+		}
+	}
+
+	var assignment map[Identifier]Term = map[Identifier]Term{}
+
+	found, err := receiver.solve(domains, assignment)
+	if nil != err || !found {
+		return nil, false, err
+	}
+
+	return assignment, true, nil
+}
+
+func (receiver *homomorphism) solve(domains map[Identifier][]Term, assignment map[Identifier]Term) (bool, error) {
+	var variable Identifier
+	{
+		var smallest int = -1
+		for _, identifier := range receiver.variables {
+			if _, assigned := assignment[identifier]; assigned {
+				continue
+			}
+			if smallest < 0 || len(domains[identifier]) < smallest {
+				variable = identifier
+				smallest = len(domains[identifier])
+			}
+		}
+
+		if smallest < 0 {
+			return true, nil
+		}
+	}
+
+	for _, value := range domains[variable] {
+		if nil != receiver.work {
+			if *receiver.work <= 0 {
+				return false, ErrBudgetExceeded
+			}
+			*receiver.work--
+		}
+
+		assignment[variable] = value
+
+		narrowed, ok := receiver.propagate(domains, assignment, variable)
+		if ok {
+			found, err := receiver.solve(narrowed, assignment)
+			if nil != err {
+				return false, err
+			}
+			if found {
+				return true, nil
+			}
+		}
+
+		delete(assignment, variable)
+	}
+
+	return false, nil
+}
+
+// propagate narrows the domains of the unassigned blank-nodes that share a quad with ‘variable’.
+func (receiver *homomorphism) propagate(domains map[Identifier][]Term, assignment map[Identifier]Term, variable Identifier) (map[Identifier][]Term, bool) {
+	var narrowed map[Identifier][]Term = maps.Clone(domains)
+	narrowed[variable] = []Term{assignment[variable]}
+
+	for _, index := range receiver.mentions[variable] {
+		var matches []Quad = receiver.matches(index, assignment)
+		if len(matches) <= 0 {
+			return nil, false
+		}
+
+		var quad Quad = receiver.source[index]
+		for _, identifier := range quad.BlankNodes() {
+			if _, assigned := assignment[identifier]; assigned {
+				continue
+			}
+
+			var domain []Term = intersectTerms(narrowed[identifier], supportOf(quad, identifier, matches))
+			if len(domain) <= 0 {
+				return nil, false
+			}
+			narrowed[identifier] = domain
+		}
+	}
+
+	return narrowed, true
+}
+
+// matches returns the quads in ‘target’ that the quad ‘source[index]’ could be mapped to, given ‘assignment’.
+func (receiver *homomorphism) matches(index int, assignment map[Identifier]Term) []Quad {
+	var quad Quad = receiver.source[index]
+	var terms [4]Term = quad.terms()
+
+	var bound [4]Term
+	var isBound [4]bool
+	for position, term := range terms {
+		identifier, isBlankNode := term.BlankNode()
+		if !isBlankNode {
+			bound[position] = term
+			isBound[position] = true
+			continue
+		}
+
+		value, assigned := assignment[identifier]
+		if assigned {
+			bound[position] = value
+			isBound[position] = true
+		}
+	}
+
+	var candidates []int
+	{
+		var smallest int = -1
+		for position := range terms {
+			if !isBound[position] {
+				continue
+			}
+
+			var list []int = receiver.index[position][bound[position]]
+			if smallest < 0 || len(list) < smallest {
+				candidates = list
+				smallest = len(list)
+			}
+		}
+
+		if smallest < 0 {
+			candidates = make([]int, len(receiver.target))
+			for i := range candidates {
+				candidates[i] = i
+			}
+		}
+	}
+
+	var result []Quad
+	for _, candidate := range candidates {
+		var target Quad = receiver.target[candidate]
+		var targetTerms [4]Term = target.terms()
+
+		var ok bool = true
+		var local map[Identifier]Term
+		for position, term := range terms {
+			if isBound[position] {
+				if bound[position] != targetTerms[position] {
+					ok = false
+					break
+				}
+				continue
+			}
+
+			// An unassigned blank-node, which might be in the quad more than once.
+			identifier, _ := term.BlankNode()
+			if nil == local {
+				local = map[Identifier]Term{}
+			}
+			value, found := local[identifier]
+			if found && value != targetTerms[position] {
+				ok = false
+				break
+			}
+			if targetTerms[position].IsNothing() {
+				ok = false
+				break
+			}
+			local[identifier] = targetTerms[position]
+		}
+
+		if ok {
+			result = append(result, target)
+		}
+	}
+
+	return result
+}
+
+// supportOf returns the (distinct, sorted) terms that ‘identifier’ is mapped to in ‘matches’ (the quads that ‘quad’ matches).
+func supportOf(quad Quad, identifier Identifier, matches []Quad) []Term {
+	var position int = -1
+	for p, term := range quad.terms() {
+		if blankNode, found := term.BlankNode(); found && blankNode == identifier {
+			position = p
+			break
+		}
+	}
+
+	var seen map[Term]struct{} = map[Term]struct{}{}
+	var support []Term
+	for _, match := range matches {
+		var term Term = match.terms()[position]
+		if _, found := seen[term]; found {
+			continue
+		}
+		seen[term] = struct{}{}
+
+		support = append(support, term)
+	}
+	slices.SortFunc(support, compareTerms)
+
+	return support
+}
+
+// intersectTerms returns the terms in both ‘a’ and ‘b’, in the order of ‘a’.
+func intersectTerms(a []Term, b []Term) []Term {
+	var set map[Term]struct{} = make(map[Term]struct{}, len(b))
+	for _, term := range b {
+		set[term] = struct{}{}
+	}
+
+	var result []Term
+	for _, term := range a {
+		if _, found := set[term]; found {
+			result = append(result, term)
+		}
+	}
+
+	return result
+}
+
+func compareTerms(a Term, b Term) int {
+	return strings.Compare(a.String(), b.String())
+}
@@ -97,7 +97,7 @@ func ParseIdentifierString(value string) (Identifier, error) {
 			return Identifier{}, ErrIdentifierPrefixNotFound
 		}
 
-		str = str[len(IdentifierPrefix):]
+		str = value[len(IdentifierPrefix):]
 	}
 
 	label, err := ParseLabelString(str)
@@ -117,7 +117,27 @@ func ParseIdentifierString(value string) (Identifier, error) {
 //	_:n1
 //	_:ed7ba470-8e54-465e-825c-99712043e01c
 //	_:label123
+//
+// The returned [Identifier] has its own copy of ‘value’, so ‘value’ can be changed or reused afterwards
+// (for example, by a [bufio.Scanner] reusing its buffer).
+// To parse without copying, see [ParseIdentifierBytesNoCopy].
 func ParseIdentifierBytes(value []byte) (Identifier, error) {
+	_, err := ParseIdentifierBytesNoCopy(value)
+	if nil != err {
+		return Identifier{}, err
+	}
+
+	return someIdentifier(someLabel(string(value[len(IdentifierPrefix):]))), nil
+}
+
+// ParseIdentifierBytesNoCopy is like [ParseIdentifierBytes], except that the returned [Identifier] refers to ‘value’ itself, rather than a copy of it.
+//
+// This avoids an allocation, but ‘value’ must not be changed (or reused) for as long as the returned [Identifier] is used —
+// otherwise, the [Identifier] silently changes too.
+// (Any error returned does not refer to ‘value’.)
+//
+// If in doubt, use [ParseIdentifierBytes].
+func ParseIdentifierBytesNoCopy(value []byte) (Identifier, error) {
 	var str string = unsafe.String(unsafe.SliceData(value), len(value))
 
 	return ParseIdentifierString(str)
@@ -179,6 +199,7 @@ func (receiver *Identifier) UnmarshalText(text []byte) error {
 		panic(ErrNilReceiver)
 	}
 
+	// ‘text’ is copied, since (for example) encoding/json may reuse it.
 	result, err := ParseIdentifierBytes(text)
 	if nil != err {
 		return err
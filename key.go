@@ -0,0 +1,94 @@
+package blanknode
+
+import (
+	"bytes"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+const (
+	keyEscape     byte = 0x00
+	keyTerminator byte = 0x01
+	keyEscapedNul byte = 0xFF
+)
+
+// AppendKey appends an order-preserving, self-delimiting encoding of ‘identifier’ to ‘dst’, and returns the extended buffer.
+//
+// This is for using blank-node-identifiers as (parts of) keys in ordered key-value stores.
+//
+// Order-preserving means that comparing the encodings with [bytes.Compare] gives the same result as comparing the blank-node-identifiers with [Compare] —
+// i.e., lexicographically by Unicode code point, which is the order that RDF Dataset Canonicalization (RDFC-1.0) uses.
+// It is not the order of [CompareNatural].
+// For example, the keys sort as:
+//
+//	_:b0
+//	_:b1
+//	_:b10
+//	_:b2
+//
+// Self-delimiting means that the end of the encoding can be found without knowing its length — so it can be followed by other parts of a composite key.
+// And a composite key with a blank-node-identifier followed by other parts still sorts by the blank-node-identifier first.
+//
+// The encoding is the (UTF-8) blank-node-label (without the "_:"), with any 0x00 byte escaped as 0x00 0xFF, followed by 0x00 0x01.
+// (A blank-node-label cannot actually contain a 0x00 byte, but the escaping keeps the encoding safe regardless.)
+// For example, "_:b0" is encoded as:
+//
+//	0x62 0x30 0x00 0x01
+//
+// [NoIdentifier] is encoded as just 0x00 0x01, which sorts before all other blank-node-identifiers.
+//
+// See [DecodeKey] for the reverse.
+func AppendKey(dst []byte, identifier Identifier) []byte {
+	label, _ := identifier.label.Get()
+
+	for index := 0; index < len(label); index++ {
+		var b byte = label[index]
+
+		dst = append(dst, b)
+		if keyEscape == b {
+			dst = append(dst, keyEscapedNul)
+		}
+	}
+
+	return append(dst, keyEscape, keyTerminator)
+}
+
+// DecodeKey decodes a blank-node-identifier encoded by [AppendKey] from the beginning of ‘key’.
+// It returns the blank-node-identifier, and the rest of ‘key’ after it.
+//
+// It returns an error that wraps [ErrKeyMalformed] if ‘key’ does not begin with such an encoding,
+// or an error from [ParseLabelString] if the decoded blank-node-label is not valid.
+func DecodeKey(key []byte) (Identifier, []byte, error) {
+	var label []byte
+
+	var rest []byte = key
+	for {
+		var index int = bytes.IndexByte(rest, keyEscape)
+		if index < 0 || len(rest) <= index+1 {
+			return Identifier{}, key, erorr.Errorf("key not terminated: %w", ErrKeyMalformed)
+		}
+
+		label = append(label, rest[:index]...)
+
+		switch rest[index+1] {
+		case keyTerminator:
+			rest = rest[index+2:]
+
+			if len(label) <= 0 {
+				return NoIdentifier(), rest, nil
+			}
+
+			parsed, err := ParseLabelString(string(label))
+			if nil != err {
+				return Identifier{}, key, err
+			}
+
+			return someIdentifier(parsed), rest, nil
+		case keyEscapedNul:
+			label = append(label, keyEscape)
+			rest = rest[index+2:]
+		default:
+			return Identifier{}, key, erorr.Errorf("key has bad escape 0x%02X: %w", rest[index+1], ErrKeyMalformed)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package blanknode
+
+import (
+	"testing"
+
+	"bytes"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing/quick"
+)
+
+// keyTestRanges are (some of) the ranges of characters allowed in a blank-node-label.
+var keyTestRanges = [][2]rune{
+	{'0', '9'},
+	{'A', 'Z'},
+	{'a', 'z'},
+	{'_', '_'},
+	{'-', '-'},
+	{'.', '.'},
+	{'·', '·'},
+	{'À', 'Ö'},
+	{'̀', 'ͯ'},
+	{'Ͱ', 'ͽ'},
+	{'‿', '⁀'},
+	{'、', '퟿'},
+	{'ﷰ', '�'},
+	{'\U00010000', '\U000EFFFF'},
+}
+
+// keyTestLabel returns a random (valid) blank-node-label.
+func keyTestLabel(randomness *rand.Rand) Label {
+	for {
+		var builder strings.Builder
+
+		var length int = 1 + randomness.Intn(6)
+		for i := 0; i < length; i++ {
+			// Mostly ASCII (so that blank-node-labels often share prefixes), but sometimes not.
+			var r [2]rune = keyTestRanges[randomness.Intn(4)]
+			if 0 == randomness.Intn(3) {
+				r = keyTestRanges[randomness.Intn(len(keyTestRanges))]
+			}
+
+			builder.WriteRune(r[0] + rune(randomness.Intn(int(r[1]-r[0])+1)))
+		}
+
+		label, err := ParseLabelString(builder.String())
+		if nil == err {
+			return label
+		}
+	}
+}
+
+func TestAppendKey(t *testing.T) {
+	tests := []struct{
+		Identifier Identifier
+		Expected   []byte
+	}{
+		{
+			Identifier: NoIdentifier(),
+			Expected:   []byte{0x00, 0x01},
+		},
+		{
+			Identifier: someIdentifier(someLabel("b0")),
+			Expected:   []byte{'b', '0', 0x00, 0x01},
+		},
+		{
+			Identifier: someIdentifier(someLabel("é")),
+			Expected:   []byte{0xC3, 0xA9, 0x00, 0x01},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := AppendKey([]byte("prefix/"), test.Identifier)
+		expected := append([]byte("prefix/"), test.Expected...)
+
+		if !bytes.Equal(expected, actual) {
+			t.Errorf("For test #%d, the actual key is not what was expected.", testNumber)
+			t.Logf("EXPECTED: % X", expected)
+			t.Logf("ACTUAL:   % X", actual)
+			continue
+		}
+	}
+}
+
+// TestAppendKey_orderCompare checks that the keys from AppendKey sort (with bytes.Compare) in the order of Compare — not CompareNatural.
+func TestAppendKey_orderCompare(t *testing.T) {
+	property := func(seed int64) bool {
+		var randomness *rand.Rand = rand.New(rand.NewSource(seed))
+
+		a := someIdentifier(keyTestLabel(randomness))
+		b := someIdentifier(keyTestLabel(randomness))
+
+		// Sometimes make one a prefix of the other.
+		if 0 == randomness.Intn(4) {
+			aLabel, _ := a.label.Get()
+			bLabel, _ := b.label.Get()
+			b = someIdentifier(someLabel(aLabel + bLabel))
+		}
+
+		var expected int = Compare(a, b)
+
+		// As the whole key.
+		if actual := bytes.Compare(AppendKey(nil, a), AppendKey(nil, b)); expected != actual {
+			t.Logf("A: %q", a)
+			t.Logf("B: %q", b)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			return false
+		}
+
+		// As the first part of a composite key — the rest of the key must not matter (unless the blank-node-identifiers are equal).
+		{
+			aKey := append(AppendKey(nil, a), 0xFF, 0xFF)
+			bKey := append(AppendKey(nil, b), 0x00)
+
+			var actual int = bytes.Compare(aKey, bKey)
+			if 0 != expected && expected != actual {
+				t.Logf("A: %q", a)
+				t.Logf("B: %q", b)
+				t.Logf("EXPECTED: %d", expected)
+				t.Logf("ACTUAL:   %d", actual)
+				return false
+			}
+		}
+
+		// NoIdentifier comes first.
+		if bytes.Compare(AppendKey(nil, NoIdentifier()), AppendKey(nil, a)) >= 0 {
+			return false
+		}
+
+		return true
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 5000}); nil != err {
+		t.Errorf("Expected bytes.Compare of the keys to agree with Compare, but it actually did not.")
+		t.Logf("ERROR: %s", err)
+	}
+}
+
+// TestAppendKey_orderNotNatural checks that "_:b10" comes before "_:b2" (as with Compare), rather than after it (as with CompareNatural).
+func TestAppendKey_orderNotNatural(t *testing.T) {
+	var identifiers = []Identifier{
+		MustParseIdentifierString("_:b0"),
+		MustParseIdentifierString("_:b1"),
+		MustParseIdentifierString("_:b10"),
+		MustParseIdentifierString("_:b2"),
+	}
+
+	for index := 1; index < len(identifiers); index++ {
+		var previous Identifier = identifiers[index-1]
+		var identifier Identifier = identifiers[index]
+
+		if actual := bytes.Compare(AppendKey(nil, previous), AppendKey(nil, identifier)); actual >= 0 {
+			t.Errorf("Expected the key for %q to come before the key for %q, but it actually did not.", previous, identifier)
+			t.Logf("ACTUAL: %d", actual)
+			continue
+		}
+	}
+}
+
+func TestDecodeKey_roundTrip(t *testing.T) {
+	property := func(seed int64, suffix []byte) bool {
+		var randomness *rand.Rand = rand.New(rand.NewSource(seed))
+
+		var identifier Identifier = someIdentifier(keyTestLabel(randomness))
+		if 0 == randomness.Intn(10) {
+			identifier = NoIdentifier()
+		}
+
+		var key []byte = AppendKey([]byte("prefix/"), identifier)
+		key = append(key, suffix...)
+
+		actual, rest, err := DecodeKey(key[len("prefix/"):])
+		if nil != err {
+			t.Logf("ERROR: (%T) %s", err, err)
+			return false
+		}
+
+		if identifier != actual {
+			t.Logf("EXPECTED: %q", identifier)
+			t.Logf("ACTUAL:   %q", actual)
+			return false
+		}
+
+		return bytes.Equal(suffix, rest)
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 5000}); nil != err {
+		t.Errorf("Expected DecodeKey to reverse AppendKey, but it actually did not.")
+		t.Logf("ERROR: %s", err)
+	}
+}
+
+func TestDecodeKey_error(t *testing.T) {
+	tests := []struct{
+		Key           []byte
+		ExpectedError error
+	}{
+		{
+			Key:           []byte("b0"),
+			ExpectedError: ErrKeyMalformed,
+		},
+		{
+			Key:           []byte{'b', '0', 0x00},
+			ExpectedError: ErrKeyMalformed,
+		},
+		{
+			Key:           []byte{'b', '0', 0x00, 0x02},
+			ExpectedError: ErrKeyMalformed,
+		},
+		{
+			Key:           []byte{'-', 'b', 0x00, 0x01},
+			ExpectedError: ErrLabelFirstCharacterNotAllowed,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, _, err := DecodeKey(test.Key)
+		if !errors.Is(err, test.ExpectedError) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("KEY:      % X", test.Key)
+			t.Logf("EXPECTED: %s", test.ExpectedError)
+			t.Logf("ACTUAL:   %v", err)
+			continue
+		}
+	}
+}
@@ -111,8 +111,28 @@ func someLabel(value string) Label {
 //
 // ( https://www.w3.org/TR/turtle/#grammar-production-PN_CHARS_BASE )
 //
+// The returned [Label] has its own copy of ‘value’, so ‘value’ can be changed or reused afterwards
+// (for example, by a [bufio.Scanner] reusing its buffer).
+// To parse without copying, see [ParseLabelBytesNoCopy].
+//
 // See also: [ParseLabelString].
 func ParseLabelBytes(value []byte) (Label, error) {
+	_, err := ParseLabelBytesNoCopy(value)
+	if nil != err {
+		return Label{}, err
+	}
+
+	return someLabel(string(value)), nil
+}
+
+// ParseLabelBytesNoCopy is like [ParseLabelBytes], except that the returned [Label] refers to ‘value’ itself, rather than a copy of it.
+//
+// This avoids an allocation, but ‘value’ must not be changed (or reused) for as long as the returned [Label] is used —
+// otherwise, the [Label] silently changes too.
+// (Any error returned does not refer to ‘value’.)
+//
+// If in doubt, use [ParseLabelBytes].
+func ParseLabelBytesNoCopy(value []byte) (Label, error) {
 	var str string = unsafe.String(unsafe.SliceData(value), len(value))
 
 	return ParseLabelString(str)
@@ -168,21 +188,35 @@ func ParseLabelBytes(value []byte) (Label, error) {
 //
 // ( https://www.w3.org/TR/turtle/#grammar-production-PN_CHARS_BASE )
 //
+// ‘value’ has to be valid UTF-8.
+// (Invalid UTF-8 is not read as U+FFFD REPLACEMENT CHARACTER, even though U+FFFD itself is allowed.)
+// For invalid UTF-8, ParseLabelString returns an error that wraps [ErrLabelInvalidUTF8].
+//
+// For a character that is not allowed, it returns an error that wraps
+// [ErrLabelFirstCharacterNotAllowed] (for the first character),
+// [ErrLabelLastCharacterNotAllowed] (for a "." as the last character), or
+// [ErrLabelCharacterNotAllowed] (for any other character).
+//
 // See also: [ParseLabelString].
 func ParseLabelString(value string) (Label, error) {
 	if "" == value {
 		return Label{}, ErrEmptyString
 	}
 
+	for index := 0; index < len(value); {
+		r, size := utf8.DecodeRuneInString(value[index:])
+
+		if utf8.RuneError == r && 1 == size {
+			return Label{}, erorr.Errorf("failed to parse blank-node-label %q due to invalid UTF-8 (byte 0x%02X) at index %d: %w", value, value[index], index, ErrLabelInvalidUTF8)
+		}
+
+		index += size
+	}
+
 	{
 		r0, _ := utf8.DecodeRuneInString(value)
 
-		switch {
-		case '\u002E' == r0                    || // '.' Full Stop
-		     '\u002D' == r0                    || // '-' Hyphen-Minus
-		     '\u00B7' == r0                    || // '·' Middle Dot
-		    ('\u0300' <= r0 && r0 <= '\u036F') ||
-		    ('\u203F' <= r0 && r0 <= '\u2040'):
+		if !labelCharacterAllowed(r0) || !labelFirstCharacterAllowed(r0) {
 			return Label{}, erorr.Errorf("failed to parse blank-node-label %q due to first character %q (%U): %w", value, r0, r0, ErrLabelFirstCharacterNotAllowed)
 		}
 	}
@@ -190,65 +224,21 @@ func ParseLabelString(value string) (Label, error) {
 	{
 		rLast, _ := utf8.DecodeLastRuneInString(value)
 
-		switch rLast {
-		case '\u002E': // '.' Full Stop
+		if !labelLastCharacterAllowed(rLast) {
 			return Label{}, erorr.Errorf("failed to parse blank-node-label %q due to last character %q (%U): %w", value, rLast, rLast, ErrLabelLastCharacterNotAllowed)
 		}
 
 	}
 
-	for index, r := range value {
-		switch {
-		case '_' == r:
-			// nothing here.
-		case          '0' <= r && r <= '9':
-			// nothing here.
-		case '.' == r:
-			// nothing here.
-		case '-' == r:
-			// nothing here.
-		case '\u00B7' == r:
-			// nothing here.
-		case     '\u0300' <= r && r <= '\u036F':
-			// nothing here.
-		case     '\u203F' <= r && r <= '\u2040':
-			// nothing here.
-
-
-
-		case          'A' <= r && r <= 'Z':
-			// nothing here.
-		case          'a' <= r && r <= 'z':
-			// nothing here.
-		case     '\u00C0' <= r && r <= '\u00D6':
-			// nothing here.
-		case     '\u00D8' <= r && r <= '\u00F6':
-			// nothing here.
-		case     '\u00F8' <= r && r <= '\u02FF':
-			// nothing here.
-		case     '\u0370' <= r && r <= '\u037D':
-			// nothing here.
-		case     '\u037F' <= r && r <= '\u1FFF':
-			// nothing here.
-		case     '\u200C' <= r && r <= '\u200D':
-			// nothing here.
-		case     '\u2070' <= r && r <= '\u218F':
-			// nothing here.
-		case     '\u2C00' <= r && r <= '\u2FEF':
-			// nothing here.
-		case     '\u3001' <= r && r <= '\uD7FF':
-			// nothing here.
-		case     '\uF900' <= r && r <= '\uFDCF':
-			// nothing here.
-		case     '\uFDF0' <= r && r <= '\uFFFD':
-			// nothing here.
-		case '\U00010000' <= r && r <= '\U000EFFFF':
-			// nothing here.
-
-
-
-		default:
-			return Label{}, erorr.Errorf("failed to parse blank-node-label %q due to %s character %q (%U): %w", value, orden.FormatInt64(int64(1+index)), r, r, ErrLabelFirstCharacterNotAllowed)
+	{
+		var position int64
+
+		for _, r := range value {
+			position++
+
+			if !labelCharacterAllowed(r) {
+				return Label{}, erorr.Errorf("failed to parse blank-node-label %q due to %s character %q (%U): %w", value, orden.FormatInt64(position), r, r, ErrLabelCharacterNotAllowed)
+			}
 		}
 	}
 
@@ -303,6 +293,7 @@ func (receiver *Label) UnmarshalText(text []byte) error {
 		panic(ErrNilReceiver)
 	}
 
+	// ‘text’ is copied, since (for example) encoding/json may reuse it.
 	result, err := ParseLabelBytes(text)
 	if nil != err {
 		return err
@@ -311,3 +302,85 @@ func (receiver *Label) UnmarshalText(text []byte) error {
 	*receiver = result
 	return nil
 }
+
+// labelFirstCharacterAllowed returns whether ‘r’ is allowed as the first character of a blank-node-label
+// (assuming it is allowed in a blank-node-label at all — see labelCharacterAllowed).
+func labelFirstCharacterAllowed(r rune) bool {
+	switch {
+	case '\u002E' == r                   || // '.' Full Stop
+	     '\u002D' == r                   || // '-' Hyphen-Minus
+	     '\u00B7' == r                   || // '·' Middle Dot
+	    ('\u0300' <= r && r <= '\u036F') ||
+	    ('\u203F' <= r && r <= '\u2040'):
+		return false
+	default:
+		return true
+	}
+}
+
+// labelLastCharacterAllowed returns whether ‘r’ is allowed as the last character of a blank-node-label
+// (assuming it is allowed in a blank-node-label at all — see labelCharacterAllowed).
+func labelLastCharacterAllowed(r rune) bool {
+	switch r {
+	case '\u002E': // '.' Full Stop
+		return false
+	default:
+		return true
+	}
+}
+
+// labelCharacterAllowed returns whether ‘r’ is allowed (somewhere) in a blank-node-label.
+func labelCharacterAllowed(r rune) bool {
+	switch {
+	case '_' == r:
+		return true
+	case          '0' <= r && r <= '9':
+		return true
+	case '.' == r:
+		return true
+	case '-' == r:
+		return true
+	case '\u00B7' == r:
+		return true
+	case     '\u0300' <= r && r <= '\u036F':
+		return true
+	case     '\u203F' <= r && r <= '\u2040':
+		return true
+
+
+
+	case          'A' <= r && r <= 'Z':
+		return true
+	case          'a' <= r && r <= 'z':
+		return true
+	case     '\u00C0' <= r && r <= '\u00D6':
+		return true
+	case     '\u00D8' <= r && r <= '\u00F6':
+		return true
+	case     '\u00F8' <= r && r <= '\u02FF':
+		return true
+	case     '\u0370' <= r && r <= '\u037D':
+		return true
+	case     '\u037F' <= r && r <= '\u1FFF':
+		return true
+	case     '\u200C' <= r && r <= '\u200D':
+		return true
+	case     '\u2070' <= r && r <= '\u218F':
+		return true
+	case     '\u2C00' <= r && r <= '\u2FEF':
+		return true
+	case     '\u3001' <= r && r <= '\uD7FF':
+		return true
+	case     '\uF900' <= r && r <= '\uFDCF':
+		return true
+	case     '\uFDF0' <= r && r <= '\uFFFD':
+		return true
+	case '\U00010000' <= r && r <= '\U000EFFFF':
+		return true
+
+
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,244 @@
+package blanknode
+
+import (
+	"encoding/base64"
+	"hash"
+	"slices"
+	"strconv"
+	"strings"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// CanonicalLabelPrefix is the prefix at the beginning of all blank-node-labels issued by RDF Dataset Canonicalization (RDFC-1.0).
+//
+// You can see the prefix in these example canonical blank-node-identifiers:
+//
+//	_:c14n0
+//	_:c14n1
+//	_:c14n2
+const CanonicalLabelPrefix string = "c14n"
+
+// LabelMapFunction returns a label map from a canonical ID map.
+//
+// This is the "label map factory function" from the W3C Verifiable Credentials Data Integrity selective-disclosure cryptosuites (such as ECDSA-SD and BBS).
+//
+// ‘canonicalIdMap’ maps each (input) blank-node-identifier to its canonical blank-node-identifier (i.e., "_:c14n0", "_:c14n1", etc).
+// The returned label map maps each (input) blank-node-identifier to a new blank-node-identifier.
+//
+// See also: [HMACLabelMapFunction], [ShuffledLabelMapFunction].
+type LabelMapFunction func(canonicalIdMap map[Identifier]Identifier) (map[Identifier]Identifier, error)
+
+// HMACLabelMapFunction returns the [LabelMapFunction] that "createHmacIdLabelMapFunction" (from the W3C Data Integrity ECDSA-SD cryptosuite) returns.
+//
+// Each canonical blank-node-identifier:
+//
+//	_:c14nN
+//
+// Is replaced with:
+//
+//	_:u<base64url(HMAC("c14nN"))>
+//
+// ‘h’ is the HMAC — for example:
+//
+//	h := hmac.New(sha256.New, hmacKey)
+//
+// ‘h’ is reset before each use.
+// The returned [LabelMapFunction] is not safe to use from multiple goroutines at the same time, because ‘h’ is not.
+func HMACLabelMapFunction(h hash.Hash) LabelMapFunction {
+	return func(canonicalIdMap map[Identifier]Identifier) (map[Identifier]Identifier, error) {
+		var result = map[Identifier]Identifier{}
+
+		for input, canonical := range canonicalIdMap {
+			label, found := canonical.label.Get()
+			if !found {
+				return nil, ErrEmptyIdentifier
+			}
+
+			var digest []byte = hashDigest(h, []byte(label))
+
+			result[input] = someIdentifier(someLabel("u" + base64.RawURLEncoding.EncodeToString(digest)))
+		}
+
+		return result, nil
+	}
+}
+
+// ShuffledLabelMapFunction returns the [LabelMapFunction] that "createShuffledIdLabelMapFunction" (from the W3C Data Integrity BBS cryptosuite) returns.
+//
+// The canonical blank-node-identifiers are replaced as they are with [HMACLabelMapFunction].
+// Then these HMAC blank-node-labels are sorted, and each is replaced with "b" followed by its (zero-based) position in the sorted order.
+// For example:
+//
+//	_:b0
+//	_:b1
+//	_:b2
+//
+// ‘h’ is the HMAC — for example:
+//
+//	h := hmac.New(sha256.New, hmacKey)
+//
+// ‘h’ is reset before each use.
+// The returned [LabelMapFunction] is not safe to use from multiple goroutines at the same time, because ‘h’ is not.
+func ShuffledLabelMapFunction(h hash.Hash) LabelMapFunction {
+	var fn LabelMapFunction = HMACLabelMapFunction(h)
+
+	return func(canonicalIdMap map[Identifier]Identifier) (map[Identifier]Identifier, error) {
+		hmacIdMap, err := fn(canonicalIdMap)
+		if nil != err {
+			return nil, err
+		}
+
+		var hmacIds []string
+		for _, identifier := range hmacIdMap {
+			hmacIds = append(hmacIds, identifier.label.String())
+		}
+		slices.Sort(hmacIds)
+
+		var result = map[Identifier]Identifier{}
+
+		for input, identifier := range hmacIdMap {
+			index, _ := slices.BinarySearch(hmacIds, identifier.label.String())
+
+			result[input] = someIdentifier(someLabel("b" + strconv.Itoa(index)))
+		}
+
+		return result, nil
+	}
+}
+
+// canonicalIndex returns the N in a canonical blank-node-identifier "_:c14nN".
+func canonicalIndex(identifier Identifier) (int, error) {
+	label, found := identifier.label.Get()
+	if !found {
+		return 0, ErrEmptyIdentifier
+	}
+
+	return prefixedIndex(label, CanonicalLabelPrefix)
+}
+
+// prefixedIndex returns the N in a blank-node-label "<prefix>N".
+func prefixedIndex(label string, prefix string) (int, error) {
+	if !strings.HasPrefix(label, prefix) {
+		return 0, erorr.Errorf("blank-node-label %q does not begin with %q: %w", label, prefix, ErrLabelMapMalformed)
+	}
+
+	var digits string = label[len(prefix):]
+	if "" == digits || ("0" != digits && '0' == digits[0]) {
+		return 0, erorr.Errorf("blank-node-label %q does not have a number after %q: %w", label, prefix, ErrLabelMapMalformed)
+	}
+	for _, r := range digits {
+		if r < '0' || '9' < r {
+			return 0, erorr.Errorf("blank-node-label %q does not have a number after %q: %w", label, prefix, ErrLabelMapMalformed)
+		}
+	}
+
+	index, err := strconv.Atoi(digits)
+	if nil != err {
+		return 0, erorr.Errorf("blank-node-label %q does not have a number after %q: %w", label, prefix, ErrLabelMapMalformed)
+	}
+
+	return index, nil
+}
+
+// CompressLabelMap compresses a label map (returned from [HMACLabelMapFunction]) the way it is compressed in an ECDSA-SD derived proof.
+//
+// Each canonical blank-node-identifier "_:c14nN" becomes the integer N.
+// And each blank-node-identifier "_:u<base64url>" becomes the bytes the base64url decodes to.
+//
+// See also: [DecompressLabelMap].
+func CompressLabelMap(labelMap map[Identifier]Identifier) (map[int][]byte, error) {
+	var result = map[int][]byte{}
+
+	for canonical, identifier := range labelMap {
+		index, err := canonicalIndex(canonical)
+		if nil != err {
+			return nil, err
+		}
+
+		label, found := identifier.label.Get()
+		if !found {
+			return nil, ErrEmptyIdentifier
+		}
+		if !strings.HasPrefix(label, "u") {
+			return nil, erorr.Errorf("blank-node-label %q does not begin with %q: %w", label, "u", ErrLabelMapMalformed)
+		}
+
+		value, err := base64.RawURLEncoding.DecodeString(label[len("u"):])
+		if nil != err {
+			return nil, erorr.Errorf("blank-node-label %q is not base64url: %w", label, ErrLabelMapMalformed)
+		}
+
+		result[index] = value
+	}
+
+	return result, nil
+}
+
+// DecompressLabelMap does the opposite of [CompressLabelMap].
+func DecompressLabelMap(compressed map[int][]byte) (map[Identifier]Identifier, error) {
+	var result = map[Identifier]Identifier{}
+
+	for index, value := range compressed {
+		if index < 0 {
+			return nil, erorr.Errorf("negative index %d: %w", index, ErrLabelMapMalformed)
+		}
+
+		var canonical Identifier = someIdentifier(someLabel(CanonicalLabelPrefix + strconv.Itoa(index)))
+
+		result[canonical] = someIdentifier(someLabel("u" + base64.RawURLEncoding.EncodeToString(value)))
+	}
+
+	return result, nil
+}
+
+// CompressShuffledLabelMap compresses a label map (returned from [ShuffledLabelMapFunction]) the way it is compressed in a BBS derived proof.
+//
+// Each canonical blank-node-identifier "_:c14nN" becomes the integer N.
+// And each blank-node-identifier "_:bM" becomes the integer M.
+//
+// See also: [DecompressShuffledLabelMap].
+func CompressShuffledLabelMap(labelMap map[Identifier]Identifier) (map[int]int, error) {
+	var result = map[int]int{}
+
+	for canonical, identifier := range labelMap {
+		index, err := canonicalIndex(canonical)
+		if nil != err {
+			return nil, err
+		}
+
+		label, found := identifier.label.Get()
+		if !found {
+			return nil, ErrEmptyIdentifier
+		}
+
+		value, err := prefixedIndex(label, "b")
+		if nil != err {
+			return nil, err
+		}
+
+		result[index] = value
+	}
+
+	return result, nil
+}
+
+// DecompressShuffledLabelMap does the opposite of [CompressShuffledLabelMap].
+func DecompressShuffledLabelMap(compressed map[int]int) (map[Identifier]Identifier, error) {
+	var result = map[Identifier]Identifier{}
+
+	for index, value := range compressed {
+		if index < 0 {
+			return nil, erorr.Errorf("negative index %d: %w", index, ErrLabelMapMalformed)
+		}
+		if value < 0 {
+			return nil, erorr.Errorf("negative value %d: %w", value, ErrLabelMapMalformed)
+		}
+
+		var canonical Identifier = someIdentifier(someLabel(CanonicalLabelPrefix + strconv.Itoa(index)))
+
+		result[canonical] = someIdentifier(someLabel("b" + strconv.Itoa(value)))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,244 @@
+package blanknode
+
+import (
+	"testing"
+
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+// labelMapTestHMACKey is the HMAC key used by the examples in the W3C Data Integrity ECDSA-SD and BBS cryptosuite specifications.
+var labelMapTestHMACKey, _ = hex.DecodeString("00112233445566778899AABBCCDDEEFF00112233445566778899AABBCCDDEEFF")
+
+func labelMapTestIdentifier(label string) Identifier {
+	return someIdentifier(someLabel(label))
+}
+
+var labelMapTestCanonicalIdMap = map[Identifier]Identifier{
+	labelMapTestIdentifier("b0"): labelMapTestIdentifier("c14n0"),
+	labelMapTestIdentifier("b1"): labelMapTestIdentifier("c14n1"),
+	labelMapTestIdentifier("b2"): labelMapTestIdentifier("c14n2"),
+	labelMapTestIdentifier("b3"): labelMapTestIdentifier("c14n3"),
+}
+
+func TestHMACLabelMapFunction(t *testing.T) {
+	var fn LabelMapFunction = HMACLabelMapFunction(hmac.New(sha256.New, labelMapTestHMACKey))
+
+	actual, err := fn(labelMapTestCanonicalIdMap)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	expected := map[Identifier]Identifier{
+		labelMapTestIdentifier("b0"): labelMapTestIdentifier("u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC38"),
+		labelMapTestIdentifier("b1"): labelMapTestIdentifier("u3Lv2QpFgo-YAegc1cQQKWJFW2sEjQF6FfuZ0VEoMKHg"),
+		labelMapTestIdentifier("b2"): labelMapTestIdentifier("uVkUuBrlOaELGVQWJD4M_qW5bcKEHWGNbOrPA_qAOKKw"),
+		labelMapTestIdentifier("b3"): labelMapTestIdentifier("ukR2991GJuy_Tkjem_x7pLVpS4C4GkZAcuGtiPhBfSSc"),
+	}
+
+	if len(expected) != len(actual) {
+		t.Errorf("The actual number of entries in the label-map is not what was expected.")
+		t.Logf("EXPECTED: %d", len(expected))
+		t.Logf("ACTUAL:   %d", len(actual))
+		return
+	}
+
+	for input, expectedIdentifier := range expected {
+		actualIdentifier := actual[input]
+
+		if expectedIdentifier != actualIdentifier {
+			t.Errorf("For %q, the actual blank-node-identifier is not what was expected.", input)
+			t.Logf("EXPECTED: %q", expectedIdentifier)
+			t.Logf("ACTUAL:   %q", actualIdentifier)
+			continue
+		}
+	}
+}
+
+func TestShuffledLabelMapFunction(t *testing.T) {
+	var fn LabelMapFunction = ShuffledLabelMapFunction(hmac.New(sha256.New, labelMapTestHMACKey))
+
+	actual, err := fn(labelMapTestCanonicalIdMap)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	// Sorted, the HMAC blank-node-labels are:
+	//
+	//	u3Lv2QpFgo-YAegc1cQQKWJFW2sEjQF6FfuZ0VEoMKHg (c14n1)
+	//	u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC38 (c14n0)
+	//	uVkUuBrlOaELGVQWJD4M_qW5bcKEHWGNbOrPA_qAOKKw (c14n2)
+	//	ukR2991GJuy_Tkjem_x7pLVpS4C4GkZAcuGtiPhBfSSc (c14n3)
+	expected := map[Identifier]Identifier{
+		labelMapTestIdentifier("b0"): labelMapTestIdentifier("b1"),
+		labelMapTestIdentifier("b1"): labelMapTestIdentifier("b0"),
+		labelMapTestIdentifier("b2"): labelMapTestIdentifier("b2"),
+		labelMapTestIdentifier("b3"): labelMapTestIdentifier("b3"),
+	}
+
+	if len(expected) != len(actual) {
+		t.Errorf("The actual number of entries in the label-map is not what was expected.")
+		t.Logf("EXPECTED: %d", len(expected))
+		t.Logf("ACTUAL:   %d", len(actual))
+		return
+	}
+
+	for input, expectedIdentifier := range expected {
+		actualIdentifier := actual[input]
+
+		if expectedIdentifier != actualIdentifier {
+			t.Errorf("For %q, the actual blank-node-identifier is not what was expected.", input)
+			t.Logf("EXPECTED: %q", expectedIdentifier)
+			t.Logf("ACTUAL:   %q", actualIdentifier)
+			continue
+		}
+	}
+}
+
+func TestCompressLabelMap(t *testing.T) {
+	labelMap := map[Identifier]Identifier{
+		labelMapTestIdentifier("c14n0"):  labelMapTestIdentifier("u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC38"),
+		labelMapTestIdentifier("c14n1"):  labelMapTestIdentifier("u3Lv2QpFgo-YAegc1cQQKWJFW2sEjQF6FfuZ0VEoMKHg"),
+		labelMapTestIdentifier("c14n12"): labelMapTestIdentifier("uVkUuBrlOaELGVQWJD4M_qW5bcKEHWGNbOrPA_qAOKKw"),
+	}
+
+	compressed, err := CompressLabelMap(labelMap)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	{
+		expected, _ := hex.DecodeString("e1820e667d4c1c44b5678223da15991b7478744601aa0e5f1d3c8312f6210b7f")
+		actual := compressed[0]
+
+		if !bytes.Equal(expected, actual) {
+			t.Errorf("The actual compressed value for c14n0 is not what was expected.")
+			t.Logf("EXPECTED: %x", expected)
+			t.Logf("ACTUAL:   %x", actual)
+		}
+	}
+
+	decompressed, err := DecompressLabelMap(compressed)
+	if nil != err {
+		t.Errorf("Did not expect an error when decompressing, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	if len(labelMap) != len(decompressed) {
+		t.Errorf("The actual number of entries in the decompressed label-map is not what was expected.")
+		t.Logf("EXPECTED: %d", len(labelMap))
+		t.Logf("ACTUAL:   %d", len(decompressed))
+		return
+	}
+
+	for canonical, expected := range labelMap {
+		actual := decompressed[canonical]
+
+		if expected != actual {
+			t.Errorf("For %q, the actual decompressed blank-node-identifier is not what was expected.", canonical)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+	}
+}
+
+func TestCompressShuffledLabelMap(t *testing.T) {
+	labelMap := map[Identifier]Identifier{
+		labelMapTestIdentifier("c14n0"):  labelMapTestIdentifier("b1"),
+		labelMapTestIdentifier("c14n1"):  labelMapTestIdentifier("b0"),
+		labelMapTestIdentifier("c14n10"): labelMapTestIdentifier("b10"),
+	}
+
+	compressed, err := CompressShuffledLabelMap(labelMap)
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	{
+		expected := map[int]int{
+			0:  1,
+			1:  0,
+			10: 10,
+		}
+
+		if len(expected) != len(compressed) {
+			t.Errorf("The actual number of entries in the compressed label-map is not what was expected.")
+			t.Logf("EXPECTED: %d", len(expected))
+			t.Logf("ACTUAL:   %d", len(compressed))
+			return
+		}
+
+		for key, value := range expected {
+			if actual := compressed[key]; value != actual {
+				t.Errorf("For %d, the actual compressed value is not what was expected.", key)
+				t.Logf("EXPECTED: %d", value)
+				t.Logf("ACTUAL:   %d", actual)
+				continue
+			}
+		}
+	}
+
+	decompressed, err := DecompressShuffledLabelMap(compressed)
+	if nil != err {
+		t.Errorf("Did not expect an error when decompressing, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	for canonical, expected := range labelMap {
+		actual := decompressed[canonical]
+
+		if expected != actual {
+			t.Errorf("For %q, the actual decompressed blank-node-identifier is not what was expected.", canonical)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+	}
+}
+
+func TestCompressLabelMap_fail(t *testing.T) {
+	tests := []map[Identifier]Identifier{
+		{
+			labelMapTestIdentifier("b0"): labelMapTestIdentifier("u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC38"),
+		},
+		{
+			labelMapTestIdentifier("c14n"): labelMapTestIdentifier("u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC38"),
+		},
+		{
+			labelMapTestIdentifier("c14n01"): labelMapTestIdentifier("u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC38"),
+		},
+		{
+			labelMapTestIdentifier("c14nX"): labelMapTestIdentifier("u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC38"),
+		},
+		{
+			labelMapTestIdentifier("c14n0"): labelMapTestIdentifier("b0"),
+		},
+		{
+			labelMapTestIdentifier("c14n0"): labelMapTestIdentifier("u4YIOZn1MHES1Z4Ij2hWZG3R4dEYBqg5fHTyDEvYhC"),
+		},
+	}
+
+	for testNumber, test := range tests {
+		_, err := CompressLabelMap(test)
+		if !errors.Is(err, ErrLabelMapMalformed) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED-ERROR: %s", ErrLabelMapMalformed)
+			t.Logf("ACTUAL-ERROR:   %s", err)
+			continue
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package blanknode
+
+import (
+	"encoding/json"
+	"iter"
+	"slices"
+)
+
+// Map is a map whose keys are blank-node-identifiers.
+//
+// Because the keys are [Identifier]s (rather than strings), there is no question of whether a key is stored as "_:b0" or as "b0".
+//
+// [NoIdentifier] cannot be a key of a Map.
+//
+// The zero value of Map is an empty map, ready to use.
+//
+// Iterating through a Map (with [Map.All] or [Map.Keys]) is always in the order of [Compare].
+//
+// A Map can be marshaled to and unmarshaled from JSON (see [Map.MarshalJSON]).
+// It does not have MarshalText and UnmarshalText (unlike [Set]) because there is no text form for a value of just any type ‘V’.
+//
+// For example:
+//
+//	var m blanknode.Map[string]
+//
+//	err := m.Set(identifier, "apple")
+//
+//	// ...
+//
+//	for identifier, value := range m.All() {
+//		// ...
+//	}
+type Map[V any] struct {
+	values map[Identifier]V
+}
+
+var (
+	_ json.Marshaler   = Map[string]{}
+	_ json.Unmarshaler = &Map[string]{}
+)
+
+// All returns an iterator over the keys and values in the map, in the order of [Compare] (of the keys).
+func (receiver Map[V]) All() iter.Seq2[Identifier, V] {
+	var keys []Identifier = receiver.sortedKeys()
+
+	return func(yield func(Identifier, V) bool) {
+		for _, key := range keys {
+			value, found := receiver.values[key]
+			if !found {
+				continue
+			}
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Delete removes a key (and its value) from the map.
+func (receiver *Map[V]) Delete(key Identifier) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	delete(receiver.values, key)
+}
+
+// Get returns the value for a key, and whether the key was found.
+func (receiver Map[V]) Get(key Identifier) (V, bool) {
+	value, found := receiver.values[key]
+	return value, found
+}
+
+// Has returns whether a key is in the map.
+func (receiver Map[V]) Has(key Identifier) bool {
+	_, found := receiver.values[key]
+	return found
+}
+
+// Keys returns an iterator over the keys in the map, in the order of [Compare].
+func (receiver Map[V]) Keys() iter.Seq[Identifier] {
+	var keys []Identifier = receiver.sortedKeys()
+
+	return slices.Values(keys)
+}
+
+// KeySet returns the keys of the map as a [Set].
+func (receiver Map[V]) KeySet() Set {
+	var set Set
+	for key := range receiver.values {
+		set.add(key)
+	}
+
+	return set
+}
+
+// Len returns the number of keys in the map.
+func (receiver Map[V]) Len() int {
+	return len(receiver.values)
+}
+
+// MarshalJSON makes [Map] fit [json.Marshaler].
+//
+// A map is marshaled as a JSON object whose names are the blank-node-identifiers, in the order of [Compare].
+// For example:
+//
+//	{"_:b0":"apple","_:b1":"banana","_:b10":"cherry"}
+func (receiver Map[V]) MarshalJSON() ([]byte, error) {
+	// encoding/json sorts the names of a map, and the names all begin with "_:", so they end up in the order of [Compare].
+	var m map[string]V = make(map[string]V, len(receiver.values))
+	for key, value := range receiver.values {
+		m[key.String()] = value
+	}
+
+	return json.Marshal(m)
+}
+
+// Set sets the value for a key.
+//
+// Set returns [ErrEmptyIdentifier] if ‘key’ is [NoIdentifier].
+func (receiver *Map[V]) Set(key Identifier, value V) error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+	if key.IsNothing() {
+		return ErrEmptyIdentifier
+	}
+
+	if nil == receiver.values {
+		receiver.values = map[Identifier]V{}
+	}
+
+	receiver.values[key] = value
+	return nil
+}
+
+// UnmarshalJSON makes [Map] fit [json.Unmarshaler].
+//
+// See [Map.MarshalJSON] for the format.
+func (receiver *Map[V]) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	var m map[string]V
+	if err := json.Unmarshal(data, &m); nil != err {
+		return err
+	}
+
+	var result Map[V]
+	for name, value := range m {
+		key, err := ParseIdentifierString(name)
+		if nil != err {
+			return err
+		}
+
+		if err := result.Set(key, value); nil != err {
+			return err
+		}
+	}
+
+	*receiver = result
+	return nil
+}
+
+func (receiver Map[V]) sortedKeys() []Identifier {
+	var keys []Identifier = make([]Identifier, 0, len(receiver.values))
+	for key := range receiver.values {
+		keys = append(keys, key)
+	}
+	SortIdentifiers(keys)
+
+	return keys
+}
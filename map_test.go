@@ -0,0 +1,169 @@
+package blanknode
+
+import (
+	"testing"
+
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+func TestMap_Set_noIdentifier(t *testing.T) {
+	var m Map[int]
+
+	err := m.Set(NoIdentifier(), 5)
+	if !errors.Is(err, ErrEmptyIdentifier) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED-ERROR: %s", ErrEmptyIdentifier)
+		t.Logf("ACTUAL-ERROR:   %s", err)
+	}
+
+	if expected, actual := 0, m.Len(); expected != actual {
+		t.Errorf("The actual length of the map is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+}
+
+func TestMap_MarshalJSON(t *testing.T) {
+	var m Map[string]
+
+	for _, pair := range [][2]string{
+		{"b10", "cherry"},
+		{"b2", "date"},
+		{"b0", "apple"},
+		{"b1", "banana"},
+	} {
+		if err := m.Set(someIdentifier(someLabel(pair[0])), pair[1]); nil != err {
+			t.Fatalf("Did not expect an error when setting %q, but actually got one: %s", pair[0], err)
+		}
+	}
+
+	{
+		actual, err := m.MarshalJSON()
+		if nil != err {
+			t.Errorf("Did not expect an error, but actually got one.")
+			t.Logf("ERROR: %s", err)
+			return
+		}
+
+		if expected := `{"_:b0":"apple","_:b1":"banana","_:b10":"cherry","_:b2":"date"}`; expected != string(actual) {
+			t.Errorf("The actual JSON is not what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+		}
+	}
+
+	{
+		var actual []string
+		for key, value := range m.All() {
+			actual = append(actual, key.String()+"="+value)
+		}
+
+		expected := []string{"_:b0=apple", "_:b1=banana", "_:b10=cherry", "_:b2=date"}
+
+		if !slices.Equal(expected, actual) {
+			t.Errorf("The actual iteration is not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+		}
+	}
+}
+
+func TestMap_UnmarshalJSON(t *testing.T) {
+	var actual Map[string]
+
+	if err := actual.UnmarshalJSON([]byte(`{"_:b2":"date","_:b0":"apple","_:b10":"cherry"}`)); nil != err {
+		t.Errorf("Did not expect an error, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	data, err := actual.MarshalJSON()
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	if expected := `{"_:b0":"apple","_:b10":"cherry","_:b2":"date"}`; expected != string(data) {
+		t.Errorf("The actual JSON is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", data)
+	}
+
+	for _, data := range []string{`{"b0":"apple"}`, `{"_:b0.":"apple"}`} {
+		var m Map[string]
+		if err := m.UnmarshalJSON([]byte(data)); nil == err {
+			t.Errorf("For %s, expected an error, but did not actually get one.", data)
+		}
+	}
+}
+
+func TestMap_roundTrip(t *testing.T) {
+	tests := []struct {
+		Entries map[string]int
+	}{
+		{},
+		{
+			Entries: map[string]int{"b0": 0},
+		},
+		{
+			Entries: map[string]int{"b10": 10, "b2": 2, "b0": 0, "b1": 1},
+		},
+		{
+			Entries: map[string]int{"日本": 1, "a.b": 2, "_x": 3, "0-9": 4, "a·̀ͯ‿.⁀": 5},
+		},
+	}
+
+	for testNumber, test := range tests {
+		var expected Map[int]
+		for label, value := range test.Entries {
+			if err := expected.Set(someIdentifier(someLabel(label)), value); nil != err {
+				t.Fatalf("For test #%d, did not expect an error when setting %q, but actually got one: %s", testNumber, label, err)
+			}
+		}
+
+		type document struct {
+			Map Map[int] `json:"map"`
+		}
+
+		data, err := json.Marshal(document{Map: expected})
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error from json.Marshal, but actually got one.", testNumber)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+
+		var actual document
+		if err := json.Unmarshal(data, &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error from json.Unmarshal, but actually got one.", testNumber)
+			t.Logf("JSON: %s", data)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+
+		if expected.Len() != actual.Map.Len() {
+			t.Errorf("For test #%d, the actual length is not what was expected.", testNumber)
+			t.Logf("JSON: %s", data)
+			t.Logf("EXPECTED: %d", expected.Len())
+			t.Logf("ACTUAL:   %d", actual.Map.Len())
+			continue
+		}
+
+		for key, expectedValue := range expected.All() {
+			actualValue, found := actual.Map.Get(key)
+			if !found {
+				t.Errorf("For test #%d, expected the key %q to be in the map, but it actually was not.", testNumber, key)
+				t.Logf("JSON: %s", data)
+				continue
+			}
+			if expectedValue != actualValue {
+				t.Errorf("For test #%d, the actual value for the key %q is not what was expected.", testNumber, key)
+				t.Logf("EXPECTED: %d", expectedValue)
+				t.Logf("ACTUAL:   %d", actualValue)
+				continue
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package blanknode
+
+import (
+	"slices"
+	"strings"
+)
+
+// MSG represents a Minimum Self-contained Graph (MSG).
+//
+// The MSGs of an RDF graph are the smallest pieces that the RDF graph can be split into without splitting up any blank-nodes:
+//
+// • a quad without any blank-nodes is an MSG by itself, and
+//
+// • quads that are connected to each other through shared blank-nodes are in the same MSG.
+//
+// (From: Tummarello, Morbidoni, Puliti, Piazza, "Signing individual fragments of an RDF graph", 2005.)
+//
+// See [MSGs].
+type MSG struct {
+	// Quads are the quads in the MSG, sorted by their N-Quads statement.
+	Quads []Quad
+}
+
+// Digest returns a SHA-256 digest of the MSG.
+//
+// The digest does not depend on how the blank-nodes are labeled.
+// So two MSGs that are the same (other than how their blank-nodes are labeled) have the same digest.
+// And two MSGs that are different have different digests.
+//
+// The digest is the SHA-256 of the MSG as N-Quads, with canonical blank-node-labels, with the statements sorted.
+// (The canonical blank-node-labels are not the same as those from RDFC-1.0.)
+//
+// For an MSG whose blank-nodes are highly symmetric, calculating the digest can take exponential time.
+func (receiver MSG) Digest() []byte {
+	return canonicalize(receiver.Quads).digest()
+}
+
+// MSGs decomposes quads into Minimum Self-contained Graphs (MSGs).
+//
+// Duplicate quads are removed.
+//
+// The MSGs are returned in a stable order — sorted by the N-Quads statement of their first quad.
+//
+// For example:
+//
+//	for _, msg := range blanknode.MSGs(quads) {
+//		digest := msg.Digest()
+//
+//		// ...
+//	}
+func MSGs(quads []Quad) []MSG {
+	var unique []Quad
+	{
+		var seen map[Quad]struct{} = map[Quad]struct{}{}
+		for _, quad := range quads {
+			if _, found := seen[quad]; found {
+				continue
+			}
+			seen[quad] = struct{}{}
+
+			unique = append(unique, quad)
+		}
+	}
+
+	// Union-find, over the indexes of ‘unique’.
+	var parents []int = make([]int, len(unique))
+	for index := range parents {
+		parents[index] = index
+	}
+	find := func(index int) int {
+		for parents[index] != index {
+			parents[index] = parents[parents[index]]
+			index = parents[index]
+		}
+		return index
+	}
+
+	{
+		var owners map[Identifier]int = map[Identifier]int{}
+		for index, quad := range unique {
+			for _, identifier := range quad.BlankNodes() {
+				owner, found := owners[identifier]
+				if !found {
+					owners[identifier] = index
+					continue
+				}
+
+				parents[find(index)] = find(owner)
+			}
+		}
+	}
+
+	var groups map[int][]Quad = map[int][]Quad{}
+	for index, quad := range unique {
+		var root int = find(index)
+		groups[root] = append(groups[root], quad)
+	}
+
+	var msgs []MSG = make([]MSG, 0, len(groups))
+	for _, group := range groups {
+		slices.SortFunc(group, compareQuads)
+		msgs = append(msgs, MSG{Quads:group})
+	}
+	slices.SortFunc(msgs, func(a MSG, b MSG) int {
+		return compareQuads(a.Quads[0], b.Quads[0])
+	})
+
+	return msgs
+}
+
+func compareQuads(a Quad, b Quad) int {
+	return strings.Compare(a.String(), b.String())
+}
@@ -0,0 +1,157 @@
+package blanknode
+
+import (
+	"testing"
+
+	"bytes"
+	"slices"
+)
+
+func msgTestBlankNode(label string) Term {
+	return BlankNodeTerm(someIdentifier(someLabel(label)))
+}
+
+func msgTestIRI(name string) Term {
+	return GroundTerm("<http://example.com/" + name + ">")
+}
+
+func msgTestQuad(subject Term, predicate Term, object Term) Quad {
+	return Quad{
+		Subject:   subject,
+		Predicate: predicate,
+		Object:    object,
+	}
+}
+
+// msgTestCycle returns the quads for a cycle of blank-nodes — each pointing to the next one with <http://example.com/next>.
+func msgTestCycle(labels ...string) []Quad {
+	var quads []Quad
+	for index, label := range labels {
+		var next string = labels[(index+1)%len(labels)]
+		quads = append(quads, msgTestQuad(msgTestBlankNode(label), msgTestIRI("next"), msgTestBlankNode(next)))
+	}
+
+	return quads
+}
+
+func TestMSGs(t *testing.T) {
+	quads := []Quad{
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("a")),
+		msgTestQuad(msgTestBlankNode("a"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+		msgTestQuad(msgTestBlankNode("a"), msgTestIRI("geo"), msgTestBlankNode("g")),
+		msgTestQuad(msgTestBlankNode("g"), msgTestIRI("lat"), GroundTerm(`"49.28"`)),
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("knows"), msgTestBlankNode("k")),
+		msgTestQuad(msgTestBlankNode("k"), msgTestIRI("name"), GroundTerm(`"Jane"`)),
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+	}
+
+	expected := [][]string{
+		{
+			`<http://example.com/joe> <http://example.com/address> _:a .`,
+			`_:a <http://example.com/city> "Vancouver" .`,
+			`_:a <http://example.com/geo> _:g .`,
+			`_:g <http://example.com/lat> "49.28" .`,
+		},
+		{
+			`<http://example.com/joe> <http://example.com/knows> _:k .`,
+			`_:k <http://example.com/name> "Jane" .`,
+		},
+		{
+			`<http://example.com/joe> <http://example.com/name> "Joe" .`,
+		},
+	}
+
+	var actual [][]string
+	for _, msg := range MSGs(quads) {
+		var strs []string
+		for _, quad := range msg.Quads {
+			strs = append(strs, quad.String())
+		}
+		actual = append(actual, strs)
+	}
+
+	if !slices.EqualFunc(expected, actual, slices.Equal) {
+		t.Errorf("The actual MSGs are not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+func TestMSG_Digest(t *testing.T) {
+	tests := []struct {
+		A             []Quad
+		B             []Quad
+		ExpectedEqual bool
+	}{
+		{
+			A: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+			},
+			B: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+			},
+			ExpectedEqual: true,
+		},
+		{
+			A: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joe"`)),
+			},
+			B: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("name"), GroundTerm(`"Joseph"`)),
+			},
+			ExpectedEqual: false,
+		},
+		{
+			A: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("a")),
+				msgTestQuad(msgTestBlankNode("a"), msgTestIRI("geo"), msgTestBlankNode("g")),
+				msgTestQuad(msgTestBlankNode("g"), msgTestIRI("lat"), GroundTerm(`"49.28"`)),
+			},
+			B: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("x9")),
+				msgTestQuad(msgTestBlankNode("x9"), msgTestIRI("geo"), msgTestBlankNode("b0")),
+				msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("lat"), GroundTerm(`"49.28"`)),
+			},
+			ExpectedEqual: true,
+		},
+		{
+			A: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("a")),
+				msgTestQuad(msgTestBlankNode("a"), msgTestIRI("geo"), msgTestBlankNode("g")),
+				msgTestQuad(msgTestBlankNode("g"), msgTestIRI("lat"), GroundTerm(`"49.28"`)),
+			},
+			B: []Quad{
+				msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("a")),
+				msgTestQuad(msgTestBlankNode("g"), msgTestIRI("geo"), msgTestBlankNode("a")),
+				msgTestQuad(msgTestBlankNode("g"), msgTestIRI("lat"), GroundTerm(`"49.28"`)),
+			},
+			ExpectedEqual: false,
+		},
+		{
+			A:             msgTestCycle("a", "b", "c", "d", "e", "f"),
+			B:             msgTestCycle("f", "d", "b", "e", "c", "a"),
+			ExpectedEqual: true,
+		},
+		{
+			// Color refinement alone cannot tell these apart, since every blank-node looks the same from its neighbours.
+			A:             msgTestCycle("a", "b", "c", "d", "e", "f"),
+			B:             append(msgTestCycle("a", "b", "c"), msgTestCycle("d", "e", "f")...),
+			ExpectedEqual: false,
+		},
+	}
+
+	for testNumber, test := range tests {
+		a := MSG{Quads:test.A}.Digest()
+		b := MSG{Quads:test.B}.Digest()
+
+		if expected, actual := test.ExpectedEqual, bytes.Equal(a, b); expected != actual {
+			t.Errorf("For test #%d, whether the digests are equal is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %t", expected)
+			t.Logf("ACTUAL:   %t", actual)
+			t.Logf("A: %x", a)
+			t.Logf("B: %x", b)
+			continue
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package blanknode
+
+import (
+	"testing"
+
+	"bufio"
+	"encoding/json"
+	"strings"
+)
+
+func TestParseLabelBytes_copies(t *testing.T) {
+	var buffer []byte = []byte("apple")
+
+	label, err := ParseLabelBytes(buffer)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	copy(buffer, "grape")
+
+	if expected, actual := "apple", label.String(); expected != actual {
+		t.Errorf("The actual blank-node-label changed when the []byte it was parsed from was changed.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+func TestParseLabelBytesNoCopy(t *testing.T) {
+	var buffer []byte = []byte("apple")
+
+	label, err := ParseLabelBytesNoCopy(buffer)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	if expected, actual := "apple", label.String(); expected != actual {
+		t.Errorf("The actual blank-node-label is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+
+	// This is the documented contract: the blank-node-label refers to the []byte it was parsed from.
+	copy(buffer, "grape")
+
+	if expected, actual := "grape", label.String(); expected != actual {
+		t.Errorf("Expected the actual blank-node-label to refer to the []byte it was parsed from, but it actually did not.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+
+	// Errors do not refer to the []byte.
+	buffer = []byte("-apple")
+	_, err = ParseLabelBytesNoCopy(buffer)
+	if nil == err {
+		t.Fatalf("Expected an error but did not actually get one.")
+	}
+	var message string = err.Error()
+	copy(buffer, "-grape")
+	if expected, actual := message, err.Error(); expected != actual {
+		t.Errorf("The actual error changed when the []byte it was parsed from was changed.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+func TestParseIdentifierBytes_copies(t *testing.T) {
+	var scanner *bufio.Scanner = bufio.NewScanner(strings.NewReader("_:apple\n_:grape\n_:melon\n"))
+
+	var actual []Identifier
+	for scanner.Scan() {
+		identifier, err := ParseIdentifierBytes(scanner.Bytes())
+		if nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+		actual = append(actual, identifier)
+	}
+
+	expected := []string{"_:apple", "_:grape", "_:melon"}
+	for index := range expected {
+		if expected[index] != actual[index].String() {
+			t.Errorf("For #%d, the actual blank-node-identifier changed when the bufio.Scanner reused its buffer.", index)
+			t.Logf("EXPECTED: %q", expected[index])
+			t.Logf("ACTUAL:   %q", actual[index])
+		}
+	}
+}
+
+func TestParseIdentifierBytesNoCopy(t *testing.T) {
+	var buffer []byte = []byte("_:apple")
+
+	identifier, err := ParseIdentifierBytesNoCopy(buffer)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	if expected, actual := "_:apple", identifier.String(); expected != actual {
+		t.Errorf("The actual blank-node-identifier is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+
+	// This is the documented contract: the blank-node-identifier refers to the []byte it was parsed from.
+	copy(buffer, "_:grape")
+
+	if expected, actual := "_:grape", identifier.String(); expected != actual {
+		t.Errorf("Expected the actual blank-node-identifier to refer to the []byte it was parsed from, but it actually did not.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+func TestUnmarshalText_json(t *testing.T) {
+	var data []byte = []byte(`{"identifier":"_:apple","label":"banana"}`)
+
+	var actual struct {
+		Identifier Identifier `json:"identifier"`
+		Label      Label      `json:"label"`
+	}
+	if err := json.Unmarshal(data, &actual); nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	// encoding/json passes (unescaped) strings to UnmarshalText as sub-slices of ‘data’, so reusing ‘data’ must not change what was unmarshaled.
+	copy(data, `{"identifier":"_:grape","label":"cherry"}`)
+
+	if expected, actual := "_:apple", actual.Identifier.String(); expected != actual {
+		t.Errorf("The actual blank-node-identifier changed when the JSON it was unmarshaled from was changed.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+	if expected, actual := "banana", actual.Label.String(); expected != actual {
+		t.Errorf("The actual blank-node-label changed when the JSON it was unmarshaled from was changed.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
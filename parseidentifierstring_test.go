@@ -0,0 +1,391 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func TestParseIdentifierString(t *testing.T) {
+	tests := []struct {
+		Value              string
+		ExpectedIdentifier Identifier
+		ExpectedError      error
+	}{
+		{
+			ExpectedError: ErrEmptyString,
+		},
+
+		{
+			Value:              "_:b0",
+			ExpectedIdentifier: someIdentifier(someLabel("b0")),
+		},
+		{
+			Value:              "_:address84",
+			ExpectedIdentifier: someIdentifier(someLabel("address84")),
+		},
+		{
+			Value:              "_:n1",
+			ExpectedIdentifier: someIdentifier(someLabel("n1")),
+		},
+		{
+			Value:              "_:ed7ba470-8e54-465e-825c-99712043e01c",
+			ExpectedIdentifier: someIdentifier(someLabel("ed7ba470-8e54-465e-825c-99712043e01c")),
+		},
+		{
+			Value:              "_:label123",
+			ExpectedIdentifier: someIdentifier(someLabel("label123")),
+		},
+		{
+			Value:              "_:_",
+			ExpectedIdentifier: someIdentifier(someLabel("_")),
+		},
+		{
+			Value:              "_:0",
+			ExpectedIdentifier: someIdentifier(someLabel("0")),
+		},
+		{
+			Value:              "_:a.b",
+			ExpectedIdentifier: someIdentifier(someLabel("a.b")),
+		},
+		{
+			Value:              "_:日本",
+			ExpectedIdentifier: someIdentifier(someLabel("日本")),
+		},
+
+		{
+			Value:         "b0",
+			ExpectedError: ErrIdentifierPrefixNotFound,
+		},
+		{
+			Value:         ":b0",
+			ExpectedError: ErrIdentifierPrefixNotFound,
+		},
+		{
+			Value:         "_b0",
+			ExpectedError: ErrIdentifierPrefixNotFound,
+		},
+		{
+			Value:         "_:",
+			ExpectedError: ErrEmptyString,
+		},
+		{
+			Value:         "_:-b0",
+			ExpectedError: ErrLabelFirstCharacterNotAllowed,
+		},
+		{
+			Value:         "_:.b0",
+			ExpectedError: ErrLabelFirstCharacterNotAllowed,
+		},
+		{
+			Value:         "_:b0.",
+			ExpectedError: ErrLabelLastCharacterNotAllowed,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := ParseIdentifierString(test.Value)
+		if nil != test.ExpectedError {
+			if !errors.Is(err, test.ExpectedError) {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("VALUE: %q", test.Value)
+				t.Logf("EXPECTED-ERROR: %s", test.ExpectedError)
+				t.Logf("ACTUAL-ERROR:   %v", err)
+			}
+			continue
+		}
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("VALUE: %q", test.Value)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		{
+			expected := test.ExpectedIdentifier
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual blank-node-identifier is not what was expected.", testNumber)
+				t.Logf("VALUE: %q", test.Value)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+
+		{
+			expected := test.Value
+			actual := actual.String()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual string is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+
+		{
+			actualBytes, err := ParseIdentifierBytes([]byte(test.Value))
+			if nil != err || actual != actualBytes {
+				t.Errorf("For test #%d, ParseIdentifierBytes did not agree with ParseIdentifierString.", testNumber)
+				t.Logf("EXPECTED: %q", actual)
+				t.Logf("ACTUAL:   %q", actualBytes)
+				t.Logf("ERROR: %v", err)
+				continue
+			}
+		}
+
+		{
+			var unmarshaled Identifier
+			if err := unmarshaled.UnmarshalText([]byte(test.Value)); nil != err || actual != unmarshaled {
+				t.Errorf("For test #%d, UnmarshalText did not agree with ParseIdentifierString.", testNumber)
+				t.Logf("EXPECTED: %q", actual)
+				t.Logf("ACTUAL:   %q", unmarshaled)
+				t.Logf("ERROR: %v", err)
+				continue
+			}
+		}
+	}
+}
+
+// TestParseIdentifierString_syntaxFiles runs the blank-node-label syntax cases in testdata/blanknodelabel.
+//
+// See testdata/blanknodelabel/README.md.
+func TestParseIdentifierString_syntaxFiles(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "blanknodelabel", "*.*t"))
+	if nil != err {
+		t.Fatalf("Could not list the test files: %s", err)
+	}
+	if len(files) <= 0 {
+		t.Fatalf("Expected there to be test files, but actually there were none.")
+	}
+
+	for _, file := range files {
+		var name string = filepath.Base(file)
+
+		var positive bool
+		switch {
+		case strings.HasPrefix(name, "positive-"):
+			positive = true
+		case strings.HasPrefix(name, "negative-"):
+			positive = false
+		default:
+			t.Errorf("For %s, expected the file name to begin with \"positive-\" or \"negative-\", but it actually did not.", file)
+			continue
+		}
+
+		contents, err := os.ReadFile(file)
+		if nil != err {
+			t.Errorf("For %s, could not read the test file: %s", file, err)
+			continue
+		}
+
+		syntaxTestBlankNodeLabels(t, file, string(contents), positive)
+	}
+}
+
+// TestParseIdentifierString_w3c runs the W3C Turtle and N-Triples syntax tests that involve BLANK_NODE_LABEL,
+// from the (vendored) W3C test suites — using their manifests to know which tests are positive and which are negative.
+//
+// Every positive syntax test that has a blank-node-label is run.
+// A negative syntax test is only run if it is about blank-nodes (by its name), since other negative syntax tests can have good blank-node-labels.
+//
+// See testdata/w3c/README.md.
+func TestParseIdentifierString_w3c(t *testing.T) {
+	manifests, err := filepath.Glob(filepath.Join("testdata", "w3c", "*", "manifest.ttl"))
+	if nil != err {
+		t.Fatalf("Could not list the manifests: %s", err)
+	}
+	if len(manifests) <= 0 {
+		t.Skip("The W3C test suites are not vendored — see testdata/w3c/README.md.")
+	}
+
+	var count int
+	for _, manifest := range manifests {
+		data, err := os.ReadFile(manifest)
+		if nil != err {
+			t.Errorf("Could not read the manifest %s: %s", manifest, err)
+			continue
+		}
+
+		for _, entry := range w3cManifestEntries(string(data)) {
+			if !entry.Positive && !w3cBlankNodeTestName.MatchString(entry.Name) {
+				continue
+			}
+
+			var file string = filepath.Join(filepath.Dir(manifest), filepath.FromSlash(entry.Action))
+			contents, err := os.ReadFile(file)
+			if nil != err {
+				t.Errorf("For %s, could not read the test file: %s", entry.Name, err)
+				continue
+			}
+			if len(w3cBlankNodeLabels(string(contents))) <= 0 {
+				continue
+			}
+
+			syntaxTestBlankNodeLabels(t, entry.Name, string(contents), entry.Positive)
+			count++
+		}
+	}
+
+	if count <= 0 {
+		t.Errorf("Expected the manifests to have tests with blank-node-labels, but they actually did not.")
+	}
+}
+
+// w3cBlankNodeTestName matches the names of the W3C syntax tests that are about blank-nodes.
+var w3cBlankNodeTestName = regexp.MustCompile(`(?i)b(lank)?[-_]?node|blank[-_]label`)
+
+// w3cManifestEntry is a syntax test in a W3C test-suite manifest.
+type w3cManifestEntry struct {
+	Name     string
+	Action   string
+	Positive bool
+}
+
+var (
+	w3cManifestType   = regexp.MustCompile(`\brdf:type\s+rdft:Test(?:Turtle|NTriples)(Positive|Negative)Syntax\b`)
+	w3cManifestName   = regexp.MustCompile(`\bmf:name\s+"([^"]*)"`)
+	w3cManifestAction = regexp.MustCompile(`\bmf:action\s+<([^>]*)>`)
+)
+
+// w3cManifestEntries returns the syntax tests in ‘manifest’ (a W3C test-suite manifest.ttl).
+//
+// Each entry in a manifest is its type, followed by its properties, up to the next entry's type.
+func w3cManifestEntries(manifest string) []w3cManifestEntry {
+	var entries []w3cManifestEntry
+
+	var locations [][]int = w3cManifestType.FindAllStringSubmatchIndex(manifest, -1)
+	for index, location := range locations {
+		var end int = len(manifest)
+		if index+1 < len(locations) {
+			end = locations[index+1][0]
+		}
+		var properties string = manifest[location[1]:end]
+
+		name := w3cManifestName.FindStringSubmatch(properties)
+		action := w3cManifestAction.FindStringSubmatch(properties)
+		if nil == name || nil == action {
+			continue
+		}
+
+		entries = append(entries, w3cManifestEntry{
+			Name:     name[1],
+			Action:   action[1],
+			Positive: "Positive" == manifest[location[2]:location[3]],
+		})
+	}
+
+	return entries
+}
+
+// syntaxTestBlankNodeLabels runs the blank-node-labels in ‘document’ (a Turtle or N-Triples document) through ParseIdentifierString and ParseLabelString.
+//
+// For a positive syntax test, all of them must parse.
+// For a negative syntax test, at least one of them must be rejected.
+func syntaxTestBlankNodeLabels(t *testing.T, name string, document string, positive bool) {
+	t.Helper()
+
+	var labels []string = w3cBlankNodeLabels(document)
+	if len(labels) <= 0 {
+		t.Errorf("For %s, expected the test file to have a blank-node-label, but it actually did not.", name)
+		return
+	}
+
+	var rejected int
+	for _, label := range labels {
+		var value string = IdentifierPrefix + label
+
+		identifier, identifierErr := ParseIdentifierString(value)
+		_, labelErr := ParseLabelString(label)
+
+		if (nil == identifierErr) != (nil == labelErr) {
+			t.Errorf("For %s, expected ParseIdentifierString(%q) and ParseLabelString(%q) to agree, but they actually did not.", name, value, label)
+			t.Logf("IDENTIFIER-ERROR: %v", identifierErr)
+			t.Logf("LABEL-ERROR:      %v", labelErr)
+			continue
+		}
+
+		if nil != identifierErr {
+			rejected++
+			if positive {
+				t.Errorf("For %s, did not expect ParseIdentifierString(%q) to return an error but it actually did.", name, value)
+				t.Logf("ERROR: (%T) %s", identifierErr, identifierErr)
+			}
+			continue
+		}
+
+		if value != identifier.String() {
+			t.Errorf("For %s, the actual blank-node-identifier is not what was expected.", name)
+			t.Logf("EXPECTED: %q", value)
+			t.Logf("ACTUAL:   %q", identifier)
+			continue
+		}
+	}
+
+	if !positive && rejected <= 0 {
+		t.Errorf("For %s, expected at least one of the blank-node-labels %q to be rejected, but actually none were.", name, labels)
+	}
+}
+
+// w3cBlankNodeLabels returns (without their "_:") what a Turtle or N-Triples tokenizer would see as the blank-node-labels in ‘document’.
+//
+// It skips comments, IRIs, and strings.
+// And it takes everything after a "_:" up to whitespace or a delimiter (none of which can be in a BLANK_NODE_LABEL) —
+// so a bad blank-node-label (such as "b1." or ":a") comes back whole, rather than being cut short where the grammar would stop.
+func w3cBlankNodeLabels(document string) []string {
+	var labels []string
+
+	for 0 < len(document) {
+		switch {
+		case strings.HasPrefix(document, "#"):
+			index := strings.IndexByte(document, '\n')
+			if index < 0 {
+				return labels
+			}
+			document = document[index:]
+		case strings.HasPrefix(document, "<"):
+			index := strings.IndexByte(document, '>')
+			if index < 0 {
+				return labels
+			}
+			document = document[1+index:]
+		case strings.HasPrefix(document, `"`):
+			index := strings.IndexByte(document[1:], '"')
+			for 0 <= index && '\\' == document[index] {
+				next := strings.IndexByte(document[2+index:], '"')
+				if next < 0 {
+					return labels
+				}
+				index += 1 + next
+			}
+			if index < 0 {
+				return labels
+			}
+			document = document[2+index:]
+		case strings.HasPrefix(document, IdentifierPrefix):
+			document = document[len(IdentifierPrefix):]
+			index := strings.IndexFunc(document, func(r rune) bool {
+				return unicode.IsSpace(r) || strings.ContainsRune(`<>"#;,()[]{}`, r)
+			})
+			if index < 0 {
+				index = len(document)
+			}
+			labels = append(labels, document[:index])
+			document = document[index:]
+		default:
+			_, size := utf8.DecodeRuneInString(document)
+			document = document[size:]
+		}
+	}
+
+	return labels
+}
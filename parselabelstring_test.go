@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"errors"
+	"strings"
 )
 
 func TestParseLabelString(t *testing.T) {
@@ -482,6 +483,68 @@ func TestParseLabelString(t *testing.T) {
 			Value:                   "f8dab989-fe86-45ba-b5db-ed4cb1f6b204",
 			ExpectedLabel: someLabel("f8dab989-fe86-45ba-b5db-ed4cb1f6b204"),
 		},
+
+
+
+		{
+			Value:         "!abc",
+			ExpectedError: ErrLabelFirstCharacterNotAllowed,
+		},
+		{
+			Value:         " abc",
+			ExpectedError: ErrLabelFirstCharacterNotAllowed,
+		},
+		{
+			Value:         ":abc",
+			ExpectedError: ErrLabelFirstCharacterNotAllowed,
+		},
+		{
+			Value:         "abc:def",
+			ExpectedError: ErrLabelCharacterNotAllowed,
+		},
+		{
+			Value:         "a b",
+			ExpectedError: ErrLabelCharacterNotAllowed,
+		},
+		{
+			Value:         "日本:x",
+			ExpectedError: ErrLabelCharacterNotAllowed,
+		},
+		{
+			Value:         "_\u00D7_",
+			ExpectedError: ErrLabelCharacterNotAllowed,
+		},
+
+
+
+		{
+			Value:         "a\xffb",
+			ExpectedError: ErrLabelInvalidUTF8,
+		},
+		{
+			Value:         "\xff",
+			ExpectedError: ErrLabelInvalidUTF8,
+		},
+		{
+			Value:         "\xffab",
+			ExpectedError: ErrLabelInvalidUTF8,
+		},
+		{
+			Value:         "ab\xff",
+			ExpectedError: ErrLabelInvalidUTF8,
+		},
+		{
+			Value:         "ab\xe6\x97",
+			ExpectedError: ErrLabelInvalidUTF8,
+		},
+		{
+			Value:         "ab\xed\xa0\x80cd",
+			ExpectedError: ErrLabelInvalidUTF8,
+		},
+		{
+			Value:                   "a\uFFFDb",
+			ExpectedLabel: someLabel("a\uFFFDb"),
+		},
 	}
 
 	for testNumber, test := range tests {
@@ -514,3 +577,48 @@ func TestParseLabelString(t *testing.T) {
 		}
 	}
 }
+
+func TestParseLabelString_characterNotAllowed(t *testing.T) {
+	tests := []struct {
+		Value    string
+		Expected string
+	}{
+		{
+			Value:    "ab:c",
+			Expected: "blank-node-label \"ab:c\" due to 3rd character ':' (U+003A)",
+		},
+		{
+			Value:    "日本:x",
+			Expected: "blank-node-label \"日本:x\" due to 3rd character ':' (U+003A)",
+		},
+		{
+			Value:    "é日本 x",
+			Expected: "due to 4th character ' ' (U+0020)",
+		},
+	}
+
+	for testNumber, test := range tests {
+		_, err := ParseLabelString(test.Value)
+		if !errors.Is(err, ErrLabelCharacterNotAllowed) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED-ERROR: %s", ErrLabelCharacterNotAllowed)
+			t.Logf("ACTUAL-ERROR:   %v", err)
+			t.Logf("VALUE: %q", test.Value)
+			continue
+		}
+		if errors.Is(err, ErrLabelFirstCharacterNotAllowed) {
+			t.Errorf("For test #%d, did not expect the error to be ErrLabelFirstCharacterNotAllowed, but it actually was.", testNumber)
+			t.Logf("ERROR: %s", err)
+			t.Logf("VALUE: %q", test.Value)
+			continue
+		}
+
+		if actual := err.Error(); !strings.Contains(actual, test.Expected) {
+			t.Errorf("For test #%d, the actual error message is not what was expected.", testNumber)
+			t.Logf("EXPECTED-TO-CONTAIN: %s", test.Expected)
+			t.Logf("ACTUAL:              %s", actual)
+			t.Logf("VALUE: %q", test.Value)
+			continue
+		}
+	}
+}
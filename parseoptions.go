@@ -0,0 +1,258 @@
+package blanknode
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// ParseOptions are options for [ParseLabelStringWith] and [ParseIdentifierStringWith].
+//
+// The zero value is strict parsing, with no limits — the same as [ParseLabelString] and [ParseIdentifierString].
+//
+// The limits (MaxBytes, MaxRunes, ASCIIOnly, and Scripts) are on the blank-node-label (i.e., not including the "_:").
+// They apply even when parsing is lenient — to the repaired blank-node-label — since they are not something that can be repaired.
+//
+// For example, for blank-node-labels from untrusted clients:
+//
+//	var options = blanknode.ParseOptions{
+//		MaxBytes: 256,
+//		Scripts:  []*unicode.RangeTable{unicode.Latin, unicode.Greek},
+//	}
+//
+//	identifier, _, err := blanknode.ParseIdentifierStringWith(value, options)
+type ParseOptions struct {
+	// MaxBytes is the maximum length of a blank-node-label, in bytes.
+	// Zero (or less) means no maximum.
+	//
+	// A blank-node-label that is too long returns an error that wraps [ErrLabelTooManyBytes] —
+	// without looking at the rest of it.
+	MaxBytes int
+
+	// MaxRunes is the maximum length of a blank-node-label, in runes (i.e., Unicode code points).
+	// Zero (or less) means no maximum.
+	//
+	// A blank-node-label that is too long returns an error that wraps [ErrLabelTooManyRunes].
+	MaxRunes int
+
+	// ASCIIOnly makes a blank-node-label with any non-ASCII character return an error that wraps [ErrLabelNotASCII].
+	ASCIIOnly bool
+
+	// Scripts, if not empty, are the Unicode scripts allowed in a blank-node-label (for example, [unicode.Latin]).
+	//
+	// Characters in the Common and Inherited scripts (such as digits, "_", "-", ".", and combining marks) are always allowed.
+	//
+	// A blank-node-label with a character not in any of them returns an error that wraps [ErrLabelScriptNotAllowed].
+	Scripts []*unicode.RangeTable
+
+	// Lenient makes a blank-node-label that is not valid get repaired, rather than rejected.
+	//
+	// The repairs are:
+	//
+	// • a character that is not allowed in a blank-node-label (including invalid UTF-8) is replaced with "_",
+	//
+	// • if the first character is not allowed as the first character, "_" is put before it, and
+	//
+	// • if the last character is not allowed as the last character, "_" is put after it.
+	//
+	// For example:
+	//
+	//	"-abc"   → "_-abc"
+	//	"abc."   → "abc._"
+	//	"node:1" → "node_1"
+	//	"a b"    → "a_b"
+	//
+	// Each repair is reported as a [ParseWarning].
+	//
+	// An empty blank-node-label cannot be repaired, and is still an error.
+	Lenient bool
+}
+
+// ParseWarningKind is the kind of a [ParseWarning].
+type ParseWarningKind int
+
+const (
+	// ParseWarningCharacterNotAllowed is for a character that is not allowed in a blank-node-label.
+	// It was replaced with "_".
+	ParseWarningCharacterNotAllowed ParseWarningKind = iota + 1
+
+	// ParseWarningFirstCharacterNotAllowed is for a first character that is not allowed as the first character of a blank-node-label.
+	// "_" was put before it.
+	ParseWarningFirstCharacterNotAllowed
+
+	// ParseWarningLastCharacterNotAllowed is for a last character that is not allowed as the last character of a blank-node-label.
+	// "_" was put after it.
+	ParseWarningLastCharacterNotAllowed
+)
+
+// String makes [ParseWarningKind] fit [fmt.Stringer].
+func (receiver ParseWarningKind) String() string {
+	switch receiver {
+	case ParseWarningCharacterNotAllowed:
+		return "character not allowed"
+	case ParseWarningFirstCharacterNotAllowed:
+		return "first character not allowed"
+	case ParseWarningLastCharacterNotAllowed:
+		return "last character not allowed"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseWarning describes a repair made by lenient parsing.
+//
+// See [ParseOptions].
+type ParseWarning struct {
+	Kind ParseWarningKind
+
+	// Index is the (byte) index of the character in what was parsed.
+	Index int
+
+	// Rune is the character.
+	// It is [utf8.RuneError] for invalid UTF-8.
+	Rune rune
+}
+
+// String makes [ParseWarning] fit [fmt.Stringer].
+func (receiver ParseWarning) String() string {
+	return fmt.Sprintf("%s: character %q (%U) at index %d", receiver.Kind, receiver.Rune, receiver.Rune, receiver.Index)
+}
+
+// ParseLabelStringWith is like [ParseLabelString], but with options.
+//
+// If ‘options’ makes parsing lenient, then it also returns what was repaired (if anything).
+// If ‘options’ has limits, then a blank-node-label over them returns an error.
+// See [ParseOptions].
+func ParseLabelStringWith(value string, options ParseOptions) (Label, []ParseWarning, error) {
+	if err := options.checkBytes(value); nil != err {
+		return Label{}, nil, err
+	}
+
+	if !options.Lenient {
+		label, err := ParseLabelString(value)
+		if nil != err {
+			return Label{}, nil, err
+		}
+		if err := options.check(value); nil != err {
+			return Label{}, nil, err
+		}
+
+		return label, nil, nil
+	}
+
+	if "" == value {
+		return Label{}, nil, ErrEmptyString
+	}
+
+	var warnings []ParseWarning
+	var builder strings.Builder
+
+	{
+		r0, _ := utf8.DecodeRuneInString(value)
+
+		if labelCharacterAllowed(r0) && !labelFirstCharacterAllowed(r0) {
+			warnings = append(warnings, ParseWarning{Kind: ParseWarningFirstCharacterNotAllowed, Index: 0, Rune: r0})
+			builder.WriteByte('_')
+		}
+	}
+
+	for index := 0; index < len(value); {
+		r, size := utf8.DecodeRuneInString(value[index:])
+
+		if (utf8.RuneError == r && 1 == size) || !labelCharacterAllowed(r) {
+			warnings = append(warnings, ParseWarning{Kind: ParseWarningCharacterNotAllowed, Index: index, Rune: r})
+			builder.WriteByte('_')
+		} else {
+			builder.WriteString(value[index : index+size])
+		}
+
+		index += size
+	}
+
+	{
+		rLast, size := utf8.DecodeLastRuneInString(value)
+
+		if labelCharacterAllowed(rLast) && !labelLastCharacterAllowed(rLast) {
+			warnings = append(warnings, ParseWarning{Kind: ParseWarningLastCharacterNotAllowed, Index: len(value) - size, Rune: rLast})
+			builder.WriteByte('_')
+		}
+	}
+
+	var repaired string = value
+	if 0 < len(warnings) {
+		repaired = builder.String()
+	}
+
+	if err := options.check(repaired); nil != err {
+		return Label{}, nil, err
+	}
+
+	return someLabel(repaired), warnings, nil
+}
+
+// ParseIdentifierStringWith is like [ParseIdentifierString], but with options.
+//
+// If ‘options’ makes parsing lenient, then it also returns what was repaired (if anything) in the blank-node-label.
+// (The "_:" is still required.)
+// The index of each [ParseWarning] is into ‘value’ — i.e., it includes the "_:".
+// See [ParseOptions].
+func ParseIdentifierStringWith(value string, options ParseOptions) (Identifier, []ParseWarning, error) {
+	if "" == value {
+		return Identifier{}, nil, ErrEmptyString
+	}
+	if !HasIdentifierPrefixString(value) {
+		return Identifier{}, nil, ErrIdentifierPrefixNotFound
+	}
+
+	label, warnings, err := ParseLabelStringWith(value[len(IdentifierPrefix):], options)
+	if nil != err {
+		return Identifier{}, nil, err
+	}
+
+	for index := range warnings {
+		warnings[index].Index += len(IdentifierPrefix)
+	}
+
+	return someIdentifier(label), warnings, nil
+}
+
+// checkBytes checks ‘label’ against MaxBytes.
+func (receiver ParseOptions) checkBytes(label string) error {
+	if 0 < receiver.MaxBytes && receiver.MaxBytes < len(label) {
+		return erorr.Errorf("failed to parse blank-node-label of %d bytes, since the maximum is %d bytes: %w", len(label), receiver.MaxBytes, ErrLabelTooManyBytes)
+	}
+
+	return nil
+}
+
+// check checks ‘label’ against all the limits.
+func (receiver ParseOptions) check(label string) error {
+	if err := receiver.checkBytes(label); nil != err {
+		return err
+	}
+
+	if receiver.MaxRunes <= 0 && !receiver.ASCIIOnly && len(receiver.Scripts) <= 0 {
+		return nil
+	}
+
+	var count int
+	for index, r := range label {
+		count++
+		if 0 < receiver.MaxRunes && receiver.MaxRunes < count {
+			return erorr.Errorf("failed to parse blank-node-label, since it has more than the maximum of %d runes: %w", receiver.MaxRunes, ErrLabelTooManyRunes)
+		}
+
+		if receiver.ASCIIOnly && utf8.RuneSelf <= label[index] {
+			return erorr.Errorf("failed to parse blank-node-label %q due to non-ascii character %q (%U) at index %d: %w", label, r, r, index, ErrLabelNotASCII)
+		}
+
+		if 0 < len(receiver.Scripts) && !unicode.In(r, unicode.Common, unicode.Inherited) && !unicode.In(r, receiver.Scripts...) {
+			return erorr.Errorf("failed to parse blank-node-label %q due to character %q (%U) at index %d not being in an allowed script: %w", label, r, r, index, ErrLabelScriptNotAllowed)
+		}
+	}
+
+	return nil
+}
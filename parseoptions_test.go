@@ -0,0 +1,318 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+func TestParseLabelStringWith_lenient(t *testing.T) {
+	tests := []struct {
+		Value            string
+		ExpectedLabel    Label
+		ExpectedWarnings []ParseWarning
+		ExpectedError    error
+	}{
+		{
+			ExpectedError: ErrEmptyString,
+		},
+
+
+
+		{
+			Value:         "b0",
+			ExpectedLabel: someLabel("b0"),
+		},
+		{
+			Value:         "a.b",
+			ExpectedLabel: someLabel("a.b"),
+		},
+
+
+
+		{
+			Value:         "-abc",
+			ExpectedLabel: someLabel("_-abc"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningFirstCharacterNotAllowed, Index: 0, Rune: '-'},
+			},
+		},
+		{
+			Value:         "abc.",
+			ExpectedLabel: someLabel("abc._"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningLastCharacterNotAllowed, Index: 3, Rune: '.'},
+			},
+		},
+		{
+			Value:         "node:1",
+			ExpectedLabel: someLabel("node_1"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningCharacterNotAllowed, Index: 4, Rune: ':'},
+			},
+		},
+		{
+			Value:         "a b",
+			ExpectedLabel: someLabel("a_b"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningCharacterNotAllowed, Index: 1, Rune: ' '},
+			},
+		},
+		{
+			Value:         ".",
+			ExpectedLabel: someLabel("_._"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningFirstCharacterNotAllowed, Index: 0, Rune: '.'},
+				{Kind: ParseWarningLastCharacterNotAllowed, Index: 0, Rune: '.'},
+			},
+		},
+		{
+			Value:         ":a:",
+			ExpectedLabel: someLabel("_a_"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningCharacterNotAllowed, Index: 0, Rune: ':'},
+				{Kind: ParseWarningCharacterNotAllowed, Index: 2, Rune: ':'},
+			},
+		},
+		{
+			Value:         "日本 語",
+			ExpectedLabel: someLabel("日本_語"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningCharacterNotAllowed, Index: 6, Rune: ' '},
+			},
+		},
+		{
+			Value:         "a\xffb",
+			ExpectedLabel: someLabel("a_b"),
+			ExpectedWarnings: []ParseWarning{
+				{Kind: ParseWarningCharacterNotAllowed, Index: 1, Rune: utf8.RuneError},
+			},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, actualWarnings, err := ParseLabelStringWith(test.Value, ParseOptions{Lenient: true})
+		if nil != test.ExpectedError {
+			if !errors.Is(err, test.ExpectedError) {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("VALUE: %q", test.Value)
+				t.Logf("EXPECTED-ERROR: %s", test.ExpectedError)
+				t.Logf("ACTUAL-ERROR:   %v", err)
+			}
+			continue
+		}
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("VALUE: %q", test.Value)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if expected := test.ExpectedLabel; expected != actual {
+			t.Errorf("For test #%d, the actual blank-node-label is not what was expected.", testNumber)
+			t.Logf("VALUE: %q", test.Value)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+
+		if expected := test.ExpectedWarnings; !slices.Equal(expected, actualWarnings) {
+			t.Errorf("For test #%d, the actual warnings are not what was expected.", testNumber)
+			t.Logf("VALUE: %q", test.Value)
+			t.Logf("EXPECTED: %v", expected)
+			t.Logf("ACTUAL:   %v", actualWarnings)
+			continue
+		}
+
+		if _, err := ParseLabelString(actual.String()); nil != err {
+			t.Errorf("For test #%d, expected the repaired blank-node-label to be valid, but it actually was not.", testNumber)
+			t.Logf("VALUE: %q", test.Value)
+			t.Logf("REPAIRED: %q", actual)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+	}
+}
+
+func TestParseLabelStringWith_strict(t *testing.T) {
+	for _, value := range []string{"-abc", "abc.", "node:1", "a b"} {
+		_, warnings, err := ParseLabelStringWith(value, ParseOptions{})
+		if nil == err {
+			t.Errorf("For %q, expected an error (since strict is the default) but did not actually get one.", value)
+			continue
+		}
+		if nil != warnings {
+			t.Errorf("For %q, did not expect warnings, but actually got some: %v", value, warnings)
+			continue
+		}
+	}
+
+	label, warnings, err := ParseLabelStringWith("b0", ParseOptions{})
+	if nil != err || someLabel("b0") != label || nil != warnings {
+		t.Errorf("The actual result is not what was expected.")
+		t.Logf("LABEL: %q", label)
+		t.Logf("WARNINGS: %v", warnings)
+		t.Logf("ERROR: %v", err)
+	}
+}
+
+func TestParseIdentifierStringWith(t *testing.T) {
+	actual, warnings, err := ParseIdentifierStringWith("_:node:1.", ParseOptions{Lenient: true})
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	if expected := "_:node_1._"; expected != actual.String() {
+		t.Errorf("The actual blank-node-identifier is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+
+	expectedWarnings := []ParseWarning{
+		{Kind: ParseWarningCharacterNotAllowed, Index: 6, Rune: ':'},
+		{Kind: ParseWarningLastCharacterNotAllowed, Index: 8, Rune: '.'},
+	}
+	if !slices.Equal(expectedWarnings, warnings) {
+		t.Errorf("The actual warnings are not what was expected.")
+		t.Logf("EXPECTED: %v", expectedWarnings)
+		t.Logf("ACTUAL:   %v", warnings)
+	}
+
+	if _, _, err := ParseIdentifierStringWith("node1", ParseOptions{Lenient: true}); !errors.Is(err, ErrIdentifierPrefixNotFound) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrIdentifierPrefixNotFound)
+		t.Logf("ACTUAL:   %v", err)
+	}
+
+	if _, _, err := ParseIdentifierStringWith("_:-abc", ParseOptions{}); !errors.Is(err, ErrLabelFirstCharacterNotAllowed) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrLabelFirstCharacterNotAllowed)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func TestParseLabelStringWith_limits(t *testing.T) {
+	tests := []struct {
+		Value         string
+		Options       ParseOptions
+		ExpectedError error
+	}{
+		{
+			Value:   "abcd",
+			Options: ParseOptions{MaxBytes: 4},
+		},
+		{
+			Value:         "abcde",
+			Options:       ParseOptions{MaxBytes: 4},
+			ExpectedError: ErrLabelTooManyBytes,
+		},
+		{
+			// Checked before the grammar.
+			Value:         "-abcde",
+			Options:       ParseOptions{MaxBytes: 4},
+			ExpectedError: ErrLabelTooManyBytes,
+		},
+		{
+			// The repaired blank-node-label ("_-abc") is too long.
+			Value:         "-abc",
+			Options:       ParseOptions{MaxBytes: 4, Lenient: true},
+			ExpectedError: ErrLabelTooManyBytes,
+		},
+		{
+			Value:   "日本語",
+			Options: ParseOptions{MaxRunes: 3},
+		},
+		{
+			Value:         "日本語",
+			Options:       ParseOptions{MaxBytes: 8},
+			ExpectedError: ErrLabelTooManyBytes,
+		},
+		{
+			Value:         "日本語x",
+			Options:       ParseOptions{MaxRunes: 3},
+			ExpectedError: ErrLabelTooManyRunes,
+		},
+
+
+
+		{
+			Value:   "b0_a-b.c",
+			Options: ParseOptions{ASCIIOnly: true},
+		},
+		{
+			Value:         "caf\u00E9",
+			Options:       ParseOptions{ASCIIOnly: true},
+			ExpectedError: ErrLabelNotASCII,
+		},
+		{
+			Value:         "a\u0301",
+			Options:       ParseOptions{ASCIIOnly: true},
+			ExpectedError: ErrLabelNotASCII,
+		},
+		{
+			// The repaired blank-node-label ("a_b") is ASCII.
+			Value:   "a b",
+			Options: ParseOptions{ASCIIOnly: true, Lenient: true},
+		},
+
+
+
+		{
+			Value:   "admin_01",
+			Options: ParseOptions{Scripts: []*unicode.RangeTable{unicode.Latin}},
+		},
+		{
+			// U+0301 is in the Inherited script, and "·" (U+00B7) is in the Common script.
+			Value:   "cafe\u0301\u00B7x",
+			Options: ParseOptions{Scripts: []*unicode.RangeTable{unicode.Latin}},
+		},
+		{
+			// U+0430 is CYRILLIC SMALL LETTER A.
+			Value:         "\u0430dmin",
+			Options:       ParseOptions{Scripts: []*unicode.RangeTable{unicode.Latin}},
+			ExpectedError: ErrLabelScriptNotAllowed,
+		},
+		{
+			Value:   "\u0430dmin",
+			Options: ParseOptions{Scripts: []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic}},
+		},
+		{
+			Value:         "\u03B1\u03B2\u03B3",
+			Options:       ParseOptions{Scripts: []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic}},
+			ExpectedError: ErrLabelScriptNotAllowed,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, _, err := ParseLabelStringWith(test.Value, test.Options)
+		if nil == test.ExpectedError {
+			if nil != err {
+				t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+				t.Logf("VALUE: %q", test.Value)
+				t.Logf("ERROR: (%T) %s", err, err)
+			}
+			continue
+		}
+
+		if !errors.Is(err, test.ExpectedError) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("VALUE: %q", test.Value)
+			t.Logf("EXPECTED-ERROR: %s", test.ExpectedError)
+			t.Logf("ACTUAL-ERROR:   %v", err)
+			continue
+		}
+
+		if _, _, err := ParseIdentifierStringWith(IdentifierPrefix+test.Value, test.Options); !errors.Is(err, test.ExpectedError) {
+			t.Errorf("For test #%d, the actual error (for the blank-node-identifier) is not what was expected.", testNumber)
+			t.Logf("VALUE: %q", IdentifierPrefix+test.Value)
+			t.Logf("EXPECTED-ERROR: %s", test.ExpectedError)
+			t.Logf("ACTUAL-ERROR:   %v", err)
+			continue
+		}
+	}
+}
@@ -0,0 +1,562 @@
+package blanknode
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"iter"
+	"slices"
+	"sort"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// DefaultPFCBlockSize is the block size [WritePFCSection] uses if it is not given one.
+// (It is the block size HDT tools usually use.)
+const DefaultPFCBlockSize = 16
+
+const (
+	pfcSectionType     byte = 2
+	pfcLogSequenceType byte = 1
+)
+
+var pfcCRC32C = crc32.MakeTable(crc32.Castagnoli)
+
+// WritePFCSection writes ‘identifiers’ as an HDT (Header-Dictionary-Triples) plain-front-coded (PFC) dictionary section.
+//
+// The blank-node-identifiers are written with their "_:" — the way HDT stores blank-nodes — sorted by [Compare], with duplicates removed.
+// ([NoIdentifier] is an error.)
+//
+// The layout is:
+//
+// • the section type (2), the number of strings, the number of bytes of text, and the block size — the numbers as HDT VBytes — followed by a CRC8 of those,
+//
+// • the offset of each block in the text (plus the end of the text) as an HDT log-sequence (type 1, bits per entry, number of entries, CRC8, the bit-packed entries, and a CRC32C of the entries), and
+//
+// • the text, followed by a CRC32C of the text.
+//
+// The text is made of blocks of ‘blockSize’ strings.
+// The first string of a block is written whole; each other string is written as the (VByte) length of the prefix it shares with the string before it, followed by the rest of it.
+// Each string ends with a 0x00 byte.
+//
+// If ‘blockSize’ is zero (or less), then [DefaultPFCBlockSize] is used.
+//
+// See [ReadPFCSection] for the reverse.
+func WritePFCSection(writer io.Writer, identifiers []Identifier, blockSize int) (int64, error) {
+	if blockSize <= 0 {
+		blockSize = DefaultPFCBlockSize
+	}
+
+	var strs []string
+	for _, identifier := range identifiers {
+		str, found := identifier.Get()
+		if !found {
+			return 0, erorr.Errorf("pfc section: %w", ErrEmptyIdentifier)
+		}
+		strs = append(strs, str)
+	}
+	slices.Sort(strs)
+	strs = slices.Compact(strs)
+
+	var text []byte
+	var blocks []uint64
+	for index, str := range strs {
+		if 0 == index%blockSize {
+			blocks = append(blocks, uint64(len(text)))
+			text = append(text, str...)
+		} else {
+			var previous string = strs[index-1]
+
+			var shared int
+			for shared < len(previous) && shared < len(str) && previous[shared] == str[shared] {
+				shared++
+			}
+
+			text = appendHDTVByte(text, uint64(shared))
+			text = append(text, str[shared:]...)
+		}
+		text = append(text, 0x00)
+	}
+	blocks = append(blocks, uint64(len(text)))
+
+	var counter dictionaryCountingWriter = dictionaryCountingWriter{writer: writer}
+	var buffered *bufio.Writer = bufio.NewWriter(&counter)
+
+	{
+		var preamble []byte = []byte{pfcSectionType}
+		preamble = appendHDTVByte(preamble, uint64(len(strs)))
+		preamble = appendHDTVByte(preamble, uint64(len(text)))
+		preamble = appendHDTVByte(preamble, uint64(blockSize))
+		preamble = append(preamble, hdtCRC8(preamble))
+
+		buffered.Write(preamble)
+	}
+
+	{
+		var bits int = hdtBits(uint64(len(text)))
+
+		var preamble []byte = []byte{pfcLogSequenceType, byte(bits)}
+		preamble = appendHDTVByte(preamble, uint64(len(blocks)))
+		preamble = append(preamble, hdtCRC8(preamble))
+		buffered.Write(preamble)
+
+		var data []byte = hdtPackBits(blocks, bits)
+		buffered.Write(data)
+		buffered.Write(binary.LittleEndian.AppendUint32(nil, crc32.Checksum(data, pfcCRC32C)))
+	}
+
+	buffered.Write(text)
+	buffered.Write(binary.LittleEndian.AppendUint32(nil, crc32.Checksum(text, pfcCRC32C)))
+
+	err := buffered.Flush()
+	return counter.count, err
+}
+
+// PFCSection is an HDT (Header-Dictionary-Triples) plain-front-coded (PFC) dictionary section of blank-node-identifiers.
+//
+// See [ReadPFCSection] and [WritePFCSection].
+type PFCSection struct {
+	count     uint64
+	blockSize uint64
+	blocks    []uint64
+	text      []byte
+}
+
+// ReadPFCSection reads an HDT plain-front-coded (PFC) dictionary section (as written by [WritePFCSection]), checking its CRCs.
+//
+// It returns an error that wraps [ErrChecksumMismatch] if a CRC does not match, and an error that wraps [ErrPFCSectionMalformed] if the section is otherwise not valid.
+func ReadPFCSection(reader io.Reader) (*PFCSection, error) {
+	var buffered *bufio.Reader = bufio.NewReader(reader)
+
+	var section PFCSection
+	var length uint64
+	{
+		var preamble hdtRecordingReader = hdtRecordingReader{reader: buffered}
+
+		sectionType, err := preamble.ReadByte()
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: %w", err)
+		}
+		if pfcSectionType != sectionType {
+			return nil, erorr.Errorf("pfc section: type %d is not %d: %w", sectionType, pfcSectionType, ErrPFCSectionMalformed)
+		}
+
+		for _, pointer := range []*uint64{&section.count, &length, &section.blockSize} {
+			*pointer, err = readHDTVByte(&preamble)
+			if nil != err {
+				return nil, erorr.Errorf("pfc section: %w", err)
+			}
+		}
+
+		crc, err := buffered.ReadByte()
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: %w", err)
+		}
+		if hdtCRC8(preamble.recorded) != crc {
+			return nil, erorr.Errorf("pfc section: preamble CRC8: %w", ErrChecksumMismatch)
+		}
+
+		if section.blockSize < 1 {
+			return nil, erorr.Errorf("pfc section: block size of zero: %w", ErrPFCSectionMalformed)
+		}
+	}
+
+	{
+		var preamble hdtRecordingReader = hdtRecordingReader{reader: buffered}
+
+		sequenceType, err := preamble.ReadByte()
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: %w", err)
+		}
+		if pfcLogSequenceType != sequenceType {
+			return nil, erorr.Errorf("pfc section: log-sequence type %d is not %d: %w", sequenceType, pfcLogSequenceType, ErrPFCSectionMalformed)
+		}
+
+		bits, err := preamble.ReadByte()
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: %w", err)
+		}
+		if 64 < bits {
+			return nil, erorr.Errorf("pfc section: log-sequence with %d bits: %w", bits, ErrPFCSectionMalformed)
+		}
+
+		entries, err := readHDTVByte(&preamble)
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: %w", err)
+		}
+
+		crc, err := buffered.ReadByte()
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: %w", err)
+		}
+		if hdtCRC8(preamble.recorded) != crc {
+			return nil, erorr.Errorf("pfc section: log-sequence preamble CRC8: %w", ErrChecksumMismatch)
+		}
+
+		var expectedBlocks uint64 = 1
+		if 0 < section.count {
+			expectedBlocks = (section.count-1)/section.blockSize + 2
+		}
+		// Each block has at least one string (with its 0x00 byte) in the text.
+		if expectedBlocks != entries || length < entries-1 {
+			return nil, erorr.Errorf("pfc section: %d blocks rather than %d: %w", entries, expectedBlocks, ErrPFCSectionMalformed)
+		}
+
+		data, err := readHDTBytes(buffered, (uint64(bits)*entries+7)/8)
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: log-sequence: %w", err)
+		}
+		if err := readHDTCRC32C(buffered, data); nil != err {
+			return nil, erorr.Errorf("pfc section: log-sequence: %w", err)
+		}
+
+		section.blocks = hdtUnpackBits(data, int(bits), entries)
+	}
+
+	{
+		text, err := readHDTBytes(buffered, length)
+		if nil != err {
+			return nil, erorr.Errorf("pfc section: text: %w", err)
+		}
+		section.text = text
+		if err := readHDTCRC32C(buffered, section.text); nil != err {
+			return nil, erorr.Errorf("pfc section: text: %w", err)
+		}
+	}
+
+	for index, offset := range section.blocks {
+		if length < offset || (0 < index && offset < section.blocks[index-1]) {
+			return nil, erorr.Errorf("pfc section: bad block offset %d: %w", offset, ErrPFCSectionMalformed)
+		}
+	}
+	if length != section.blocks[len(section.blocks)-1] {
+		return nil, erorr.Errorf("pfc section: last block offset %d is not the length of the text %d: %w", section.blocks[len(section.blocks)-1], length, ErrPFCSectionMalformed)
+	}
+
+	return &section, nil
+}
+
+// All returns an iterator over the blank-node-identifiers in the section, in order.
+//
+// It stops at the first one that cannot be decoded.
+func (receiver *PFCSection) All() iter.Seq[Identifier] {
+	return func(yield func(Identifier) bool) {
+		if nil == receiver {
+			return
+		}
+
+		for block := uint64(0); block+1 < uint64(len(receiver.blocks)); block++ {
+			var stop bool
+			receiver.block(block, func(str []byte) bool {
+				identifier, err := pfcIdentifier(str)
+				if nil != err || !yield(identifier) {
+					stop = true
+					return false
+				}
+				return true
+			})
+			if stop {
+				return
+			}
+		}
+	}
+}
+
+// BlockSize returns the block size of the section.
+func (receiver *PFCSection) BlockSize() int {
+	if nil == receiver {
+		return 0
+	}
+
+	return int(receiver.blockSize)
+}
+
+// Identifier returns the blank-node-identifier with the ID ‘id’.
+//
+// IDs in HDT start at 1 (not 0).
+func (receiver *PFCSection) Identifier(id uint64) (Identifier, error) {
+	if nil == receiver || id < 1 || receiver.count < id {
+		return Identifier{}, erorr.Errorf("pfc section: no ID %d: %w", id, ErrNotFound)
+	}
+
+	var block uint64 = (id - 1) / receiver.blockSize
+	var position uint64 = (id - 1) % receiver.blockSize
+
+	var result []byte
+	var err error = erorr.Errorf("pfc section: ID %d: %w", id, ErrPFCSectionMalformed)
+	var index uint64
+	receiver.block(block, func(str []byte) bool {
+		if index == position {
+			result = str
+			err = nil
+			return false
+		}
+		index++
+		return true
+	})
+	if nil != err {
+		return Identifier{}, err
+	}
+
+	return pfcIdentifier(result)
+}
+
+// Len returns the number of blank-node-identifiers in the section.
+func (receiver *PFCSection) Len() int {
+	if nil == receiver {
+		return 0
+	}
+
+	return int(receiver.count)
+}
+
+// Locate returns the ID of ‘identifier’ in the section, if it is in the section.
+//
+// IDs in HDT start at 1 (not 0).
+func (receiver *PFCSection) Locate(identifier Identifier) (uint64, bool) {
+	str, found := identifier.Get()
+	if nil == receiver || !found {
+		return 0, false
+	}
+	var target []byte = []byte(str)
+
+	// Find the last block whose first string is not after ‘str’.
+	var blockCount int = len(receiver.blocks) - 1
+	var block int = sort.Search(blockCount, func(i int) bool {
+		return 0 < bytes.Compare(receiver.firstOfBlock(uint64(i)), target)
+	}) - 1
+	if block < 0 {
+		return 0, false
+	}
+
+	var id uint64
+	var position uint64
+	receiver.block(uint64(block), func(s []byte) bool {
+		if bytes.Equal(s, target) {
+			id = uint64(block)*receiver.blockSize + position + 1
+			return false
+		}
+		position++
+		return true
+	})
+
+	return id, 0 < id
+}
+
+func (receiver *PFCSection) firstOfBlock(block uint64) []byte {
+	var text []byte = receiver.text[receiver.blocks[block]:]
+
+	var end int = bytes.IndexByte(text, 0x00)
+	if end < 0 {
+		return text
+	}
+
+	return text[:end]
+}
+
+// block calls ‘fn’ with each string in the block, until ‘fn’ returns false.
+func (receiver *PFCSection) block(block uint64, fn func([]byte) bool) {
+	var text []byte = receiver.text[receiver.blocks[block]:receiver.blocks[block+1]]
+
+	var previous []byte
+	for index := uint64(0); index < receiver.blockSize && 0 < len(text); index++ {
+		var current []byte
+		if 0 == index {
+			var end int = bytes.IndexByte(text, 0x00)
+			if end < 0 {
+				return
+			}
+			current = text[:end]
+			text = text[end+1:]
+		} else {
+			shared, n := decodeHDTVByte(text)
+			if n <= 0 || uint64(len(previous)) < shared {
+				return
+			}
+			text = text[n:]
+
+			var end int = bytes.IndexByte(text, 0x00)
+			if end < 0 {
+				return
+			}
+			current = append(slices.Clip(previous[:shared]), text[:end]...)
+			text = text[end+1:]
+		}
+
+		if !fn(current) {
+			return
+		}
+		previous = current
+	}
+}
+
+func pfcIdentifier(str []byte) (Identifier, error) {
+	if !HasIdentifierPrefixBytes(str) {
+		return Identifier{}, erorr.Errorf("pfc section: %q: %w", str, ErrIdentifierPrefixNotFound)
+	}
+
+	label, err := ParseLabelString(string(str[len(IdentifierPrefix):]))
+	if nil != err {
+		return Identifier{}, erorr.Errorf("pfc section: %w", err)
+	}
+
+	return someIdentifier(label), nil
+}
+
+// appendHDTVByte appends ‘value’ as an HDT VByte — 7 bits per byte, least-significant first, with the high bit set on the LAST byte.
+// (This is the opposite of the usual varint, where the high bit is set on every byte but the last.)
+func appendHDTVByte(dst []byte, value uint64) []byte {
+	for 127 < value {
+		dst = append(dst, byte(value&127))
+		value >>= 7
+	}
+
+	return append(dst, byte(value)|0x80)
+}
+
+// decodeHDTVByte decodes an HDT VByte from the beginning of ‘data’, and returns it and the number of bytes it was.
+// The number of bytes is zero (or less) if ‘data’ does not begin with an HDT VByte.
+func decodeHDTVByte(data []byte) (uint64, int) {
+	var value uint64
+	for index, b := range data {
+		if 9 < index {
+			return 0, -1
+		}
+
+		value |= uint64(b&127) << (7 * index)
+		if 0 != b&0x80 {
+			return value, index + 1
+		}
+	}
+
+	return 0, 0
+}
+
+func readHDTVByte(reader io.ByteReader) (uint64, error) {
+	var value uint64
+	for index := 0; ; index++ {
+		if 9 < index {
+			return 0, erorr.Errorf("vbyte too long: %w", ErrPFCSectionMalformed)
+		}
+
+		b, err := reader.ReadByte()
+		if nil != err {
+			return 0, err
+		}
+
+		value |= uint64(b&127) << (7 * index)
+		if 0 != b&0x80 {
+			return value, nil
+		}
+	}
+}
+
+// readHDTBytes reads ‘length’ bytes — without trusting ‘length’ enough to allocate it all up front.
+func readHDTBytes(reader io.Reader, length uint64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, int64(min(length, 1<<62))))
+	if nil != err {
+		return nil, err
+	}
+	if uint64(len(data)) != length {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return data, nil
+}
+
+func readHDTCRC32C(reader io.Reader, data []byte) error {
+	var crc [4]byte
+	if _, err := io.ReadFull(reader, crc[:]); nil != err {
+		return err
+	}
+	if crc32.Checksum(data, pfcCRC32C) != binary.LittleEndian.Uint32(crc[:]) {
+		return erorr.Errorf("CRC32C: %w", ErrChecksumMismatch)
+	}
+
+	return nil
+}
+
+// hdtRecordingReader is an io.ByteReader that records the bytes read (for computing a CRC of them).
+type hdtRecordingReader struct {
+	reader   io.ByteReader
+	recorded []byte
+}
+
+func (receiver *hdtRecordingReader) ReadByte() (byte, error) {
+	b, err := receiver.reader.ReadByte()
+	if nil == err {
+		receiver.recorded = append(receiver.recorded, b)
+	}
+
+	return b, err
+}
+
+// hdtCRC8 returns the CRC8 (CCITT — polynomial 0x07, initial value 0) of ‘data’, which is what HDT uses for preambles.
+func hdtCRC8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if 0 != crc&0x80 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+// hdtBits returns the number of bits needed for ‘value’.
+func hdtBits(value uint64) int {
+	var bits int
+	for 0 < value {
+		bits++
+		value >>= 1
+	}
+
+	return bits
+}
+
+// hdtPackBits packs ‘values’ with ‘bits’ bits each, least-significant bit first (as 64-bit little-endian words),
+// and returns the (bits × number of values + 7) / 8 bytes of them.
+func hdtPackBits(values []uint64, bits int) []byte {
+	var total uint64 = uint64(bits) * uint64(len(values))
+	var words []uint64 = make([]uint64, (total+63)/64)
+
+	for index, value := range values {
+		var position uint64 = uint64(index) * uint64(bits)
+		for bit := 0; bit < bits; bit++ {
+			if 0 != value&(1<<bit) {
+				var p uint64 = position + uint64(bit)
+				words[p/64] |= 1 << (p % 64)
+			}
+		}
+	}
+
+	var data []byte
+	for _, word := range words {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+
+	return data[:(total+7)/8]
+}
+
+func hdtUnpackBits(data []byte, bits int, count uint64) []uint64 {
+	var values []uint64 = make([]uint64, count)
+
+	for index := range values {
+		var position uint64 = uint64(index) * uint64(bits)
+		for bit := 0; bit < bits; bit++ {
+			var p uint64 = position + uint64(bit)
+			if 0 != data[p/8]&(1<<(p%8)) {
+				values[index] |= 1 << bit
+			}
+		}
+	}
+
+	return values
+}
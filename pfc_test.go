@@ -0,0 +1,187 @@
+package blanknode
+
+import (
+	"testing"
+
+	"bytes"
+	"errors"
+	"slices"
+	"strconv"
+)
+
+func TestWritePFCSection(t *testing.T) {
+	identifiers := []Identifier{
+		someIdentifier(someLabel("b10")),
+		someIdentifier(someLabel("b0")),
+		someIdentifier(someLabel("b1")),
+		someIdentifier(someLabel("b0")),
+	}
+
+	var buffer bytes.Buffer
+	n, err := WritePFCSection(&buffer, identifiers, 2)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	// Computed independently of this package.
+	expected := []byte(
+		"\x02\x83\x8e\x82\x7d" + // type 2, 3 strings, 14 bytes of text, block size 2, CRC8
+		"\x01\x04\x83\xbf" + // log-sequence type 1, 4 bits, 3 entries, CRC8
+		"\x80\x0e" + // block offsets 0, 8, 14
+		"\x0c\x32\xda\xa6" + // CRC32C
+		"_:b0\x00" + "\x831\x00" + // block 0: "_:b0", "_:b1" (sharing 3 bytes with "_:b0")
+		"_:b10\x00" + // block 1
+		"\xfb\x62\x58\x9f", // CRC32C
+	)
+	actual := buffer.Bytes()
+
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("The actual PFC section is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+		return
+	}
+
+	if int64(len(expected)) != n {
+		t.Errorf("The actual number of bytes written is not what was expected.")
+		t.Logf("EXPECTED: %d", len(expected))
+		t.Logf("ACTUAL:   %d", n)
+	}
+}
+
+func TestReadPFCSection(t *testing.T) {
+	for _, count := range []int{0, 1, 15, 16, 17, 1000} {
+		var identifiers []Identifier
+		for i := 0; i < count; i++ {
+			identifiers = append(identifiers, someIdentifier(someLabel("node"+strconv.Itoa(i))))
+		}
+
+		var buffer bytes.Buffer
+		if _, err := WritePFCSection(&buffer, identifiers, 0); nil != err {
+			t.Errorf("For %d blank-node-identifiers, did not expect an error but actually got one.", count)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		section, err := ReadPFCSection(&buffer)
+		if nil != err {
+			t.Errorf("For %d blank-node-identifiers, did not expect an error but actually got one.", count)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if expected, actual := count, section.Len(); expected != actual {
+			t.Errorf("For %d blank-node-identifiers, the actual length is not what was expected.", count)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			continue
+		}
+		if expected, actual := DefaultPFCBlockSize, section.BlockSize(); expected != actual {
+			t.Errorf("For %d blank-node-identifiers, the actual block size is not what was expected.", count)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			continue
+		}
+
+		var expected []Identifier = slices.Clone(identifiers)
+		SortIdentifiers(expected)
+
+		var actual []Identifier = slices.Collect(section.All())
+		if !slices.Equal(expected, actual) {
+			t.Errorf("For %d blank-node-identifiers, the actual blank-node-identifiers are not what was expected.", count)
+			t.Logf("EXPECTED: %v", expected)
+			t.Logf("ACTUAL:   %v", actual)
+			continue
+		}
+
+		for index, identifier := range expected {
+			var id uint64 = uint64(index) + 1
+
+			actualIdentifier, err := section.Identifier(id)
+			if nil != err || identifier != actualIdentifier {
+				t.Errorf("For %d blank-node-identifiers, the actual blank-node-identifier for ID %d is not what was expected.", count, id)
+				t.Logf("EXPECTED: %s", identifier)
+				t.Logf("ACTUAL:   %s", actualIdentifier)
+				t.Logf("ERROR: %v", err)
+				break
+			}
+
+			actualID, found := section.Locate(identifier)
+			if !found || id != actualID {
+				t.Errorf("For %d blank-node-identifiers, the actual ID for %s is not what was expected.", count, identifier)
+				t.Logf("EXPECTED: %d", id)
+				t.Logf("ACTUAL:   %d (found=%t)", actualID, found)
+				break
+			}
+		}
+
+		if _, found := section.Locate(someIdentifier(someLabel("missing"))); found {
+			t.Errorf("For %d blank-node-identifiers, did not expect a missing blank-node-identifier to be found, but it actually was.", count)
+		}
+		if _, err := section.Identifier(uint64(count) + 1); !errors.Is(err, ErrNotFound) {
+			t.Errorf("For %d blank-node-identifiers, the actual error is not what was expected.", count)
+			t.Logf("EXPECTED: %s", ErrNotFound)
+			t.Logf("ACTUAL:   %v", err)
+		}
+	}
+}
+
+func TestReadPFCSection_error(t *testing.T) {
+	var valid []byte
+	{
+		var buffer bytes.Buffer
+		WritePFCSection(&buffer, []Identifier{someIdentifier(someLabel("b0")), someIdentifier(someLabel("b1"))}, 2)
+		valid = buffer.Bytes()
+	}
+
+	corrupt := func(index int) []byte {
+		var data []byte = slices.Clone(valid)
+		data[index] ^= 0x01
+		return data
+	}
+
+	tests := []struct{
+		Data          []byte
+		ExpectedError error
+	}{
+		{
+			Data:          corrupt(0),
+			ExpectedError: ErrPFCSectionMalformed,
+		},
+		{
+			Data:          corrupt(1),
+			ExpectedError: ErrChecksumMismatch,
+		},
+		{
+			Data:          corrupt(6),
+			ExpectedError: ErrChecksumMismatch,
+		},
+		{
+			// In the block offsets.
+			Data:          corrupt(9),
+			ExpectedError: ErrChecksumMismatch,
+		},
+		{
+			// In the text.
+			Data:          corrupt(len(valid) - 6),
+			ExpectedError: ErrChecksumMismatch,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := ReadPFCSection(bytes.NewReader(test.Data))
+		if !errors.Is(err, test.ExpectedError) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", test.ExpectedError)
+			t.Logf("ACTUAL:   %v", err)
+			continue
+		}
+	}
+
+	if _, err := ReadPFCSection(bytes.NewReader(valid[:len(valid)-1])); nil == err {
+		t.Errorf("Expected an error for a truncated PFC section, but did not actually get one.")
+	}
+}
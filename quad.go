@@ -0,0 +1,166 @@
+package blanknode
+
+import (
+	"strings"
+)
+
+// Term represents an RDF term.
+//
+// A Term is either:
+//
+// • a blank-node (see [BlankNodeTerm]), or
+//
+// • a ground term — i.e., an IRI or a literal (see [GroundTerm]).
+//
+// This package is about blank-nodes, so other than blank-nodes, it does not look inside of terms.
+// A ground term is held as its N-Quads serialization, and two ground terms are the same if their N-Quads serializations are the same.
+//
+// The zero value of Term is nothing, which is used (for example) for the graph of a [Quad] in the default graph.
+type Term struct {
+	blankNode Identifier
+	ground    string
+}
+
+// BlankNodeTerm returns a [Term] for a blank-node.
+func BlankNodeTerm(identifier Identifier) Term {
+	return Term{blankNode:identifier}
+}
+
+// GroundTerm returns a [Term] for a ground term (i.e., an IRI or a literal).
+//
+// ‘value’ is the N-Quads serialization of the term.
+// For example:
+//
+//	blanknode.GroundTerm("<http://example.com/apple>")
+//
+//	blanknode.GroundTerm(`"apple"`)
+//
+//	blanknode.GroundTerm(`"apple"@en`)
+//
+//	blanknode.GroundTerm(`"5"^^<http://www.w3.org/2001/XMLSchema#integer>`)
+//
+// GroundTerm does not validate ‘value’.
+func GroundTerm(value string) Term {
+	return Term{ground:value}
+}
+
+// BlankNode returns the blank-node-identifier, if the term is a blank-node.
+func (receiver Term) BlankNode() (Identifier, bool) {
+	return receiver.blankNode, !receiver.blankNode.IsNothing()
+}
+
+// Ground returns the N-Quads serialization, if the term is a ground term.
+func (receiver Term) Ground() (string, bool) {
+	return receiver.ground, "" != receiver.ground
+}
+
+// IsBlankNode returns whether the term is a blank-node.
+func (receiver Term) IsBlankNode() bool {
+	return !receiver.blankNode.IsNothing()
+}
+
+// IsNothing returns whether the term is nothing.
+func (receiver Term) IsNothing() bool {
+	return receiver.blankNode.IsNothing() && "" == receiver.ground
+}
+
+// String makes [Term] fit [fmt.Stringer].
+//
+// It returns the N-Quads serialization of the term.
+func (receiver Term) String() string {
+	if !receiver.blankNode.IsNothing() {
+		return receiver.blankNode.String()
+	}
+
+	return receiver.ground
+}
+
+// format returns the N-Quads serialization of the term, with ‘label’ providing the blank-node-identifier for a blank-node.
+func (receiver Term) format(label func(Identifier) string) string {
+	if !receiver.blankNode.IsNothing() {
+		return label(receiver.blankNode)
+	}
+
+	return receiver.ground
+}
+
+// Quad represents an RDF quad — i.e., an RDF triple plus the graph it is in.
+//
+// If ‘Graph’ is nothing, then the quad is in the default graph.
+// (So a Quad can also be used to represent an RDF triple.)
+type Quad struct {
+	Subject   Term
+	Predicate Term
+	Object    Term
+	Graph     Term
+}
+
+// BlankNodes returns the (distinct) blank-node-identifiers in the quad, in the order: subject, predicate, object, graph.
+func (receiver Quad) BlankNodes() []Identifier {
+	var identifiers []Identifier
+
+	for _, term := range receiver.terms() {
+		identifier, found := term.BlankNode()
+		if !found {
+			continue
+		}
+
+		var duplicate bool
+		for _, existing := range identifiers {
+			if existing == identifier {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+
+	return identifiers
+}
+
+// HasBlankNode returns whether the quad has any blank-nodes in it.
+func (receiver Quad) HasBlankNode() bool {
+	for _, term := range receiver.terms() {
+		if term.IsBlankNode() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String makes [Quad] fit [fmt.Stringer].
+//
+// It returns the quad as an N-Quads statement (without the trailing newline).
+// For example:
+//
+//	_:b0 <http://example.com/name> "Joe" .
+func (receiver Quad) String() string {
+	return receiver.format(func(identifier Identifier) string {
+		return identifier.String()
+	})
+}
+
+// format returns the quad as an N-Quads statement, with ‘label’ providing the blank-node-identifier for each blank-node.
+func (receiver Quad) format(label func(Identifier) string) string {
+	var builder strings.Builder
+
+	builder.WriteString(receiver.Subject.format(label))
+	builder.WriteByte(' ')
+	builder.WriteString(receiver.Predicate.format(label))
+	builder.WriteByte(' ')
+	builder.WriteString(receiver.Object.format(label))
+	if !receiver.Graph.IsNothing() {
+		builder.WriteByte(' ')
+		builder.WriteString(receiver.Graph.format(label))
+	}
+	builder.WriteString(" .")
+
+	return builder.String()
+}
+
+func (receiver Quad) terms() [4]Term {
+	return [4]Term{receiver.Subject, receiver.Predicate, receiver.Object, receiver.Graph}
+}
@@ -0,0 +1,532 @@
+package blanknode
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// RDF Patch row codes.
+//
+// See: https://afs.github.io/rdf-patch/
+const (
+	PatchHeader            = "H"
+	PatchTransactionBegin  = "TX"
+	PatchTransactionCommit = "TC"
+	PatchTransactionAbort  = "TA"
+	PatchPrefixAdd         = "PA"
+	PatchPrefixDelete      = "PD"
+	PatchAdd               = "A"
+	PatchDelete            = "D"
+)
+
+// PatchRow represents a row of an RDF Patch.
+//
+// For example, these are rows of an RDF Patch:
+//
+//	H id <uuid:0686c69d-8f89-4496-acb5-744f0157a8db> .
+//	TX .
+//	PA "ex" "http://example.com/" .
+//	A _:b0 <http://example.com/name> "Joe" .
+//	D <http://example.com/joe> ex:knows _:b1 <http://example.com/graph> .
+//	TC .
+//
+// The blank-node-labels in an RDF Patch are scoped to the RDF Patch.
+// See [PatchScope] for mapping them to the blank-nodes of a store.
+type PatchRow struct {
+	// Code is what kind of row this is — "H", "TX", "TC", "TA", "PA", "PD", "A", or "D".
+	Code string
+
+	// Name is the name of the header, for an "H" row.
+	// For example, "id" or "prev".
+	Name string
+
+	// Terms are the terms of the row:
+	//
+	// • for an "H" row, the header value;
+	//
+	// • for a "PA" row, the prefix and the IRI (both as literals);
+	//
+	// • for a "PD" row, the prefix (as a literal);
+	//
+	// • for an "A" or "D" row, the subject, predicate, object, and (optionally) graph.
+	//
+	// Prefixed names (such as "ex:knows") are expanded to IRIs when an RDF Patch is read.
+	Terms []Term
+}
+
+// Quad returns the quad of an "A" or "D" row.
+func (receiver PatchRow) Quad() (Quad, bool) {
+	switch receiver.Code {
+	case PatchAdd, PatchDelete:
+	default:
+		return Quad{}, false
+	}
+
+	var quad Quad
+	switch len(receiver.Terms) {
+	case 4:
+		quad.Graph = receiver.Terms[3]
+		fallthrough
+	case 3:
+		quad.Subject = receiver.Terms[0]
+		quad.Predicate = receiver.Terms[1]
+		quad.Object = receiver.Terms[2]
+	default:
+		return Quad{}, false
+	}
+
+	return quad, true
+}
+
+// String makes [PatchRow] fit [fmt.Stringer].
+//
+// It returns the row as a line of an RDF Patch (without the trailing newline).
+func (receiver PatchRow) String() string {
+	var builder strings.Builder
+
+	builder.WriteString(receiver.Code)
+	if "" != receiver.Name {
+		builder.WriteByte(' ')
+		builder.WriteString(receiver.Name)
+	}
+	for _, term := range receiver.Terms {
+		builder.WriteByte(' ')
+		builder.WriteString(term.String())
+	}
+	builder.WriteString(" .")
+
+	return builder.String()
+}
+
+// PatchRowForQuad returns an "A" or "D" row for a quad.
+func PatchRowForQuad(code string, quad Quad) PatchRow {
+	var terms []Term = []Term{quad.Subject, quad.Predicate, quad.Object}
+	if !quad.Graph.IsNothing() {
+		terms = append(terms, quad.Graph)
+	}
+
+	return PatchRow{
+		Code:  code,
+		Terms: terms,
+	}
+}
+
+// PatchReader reads the rows of an RDF Patch.
+//
+// For example:
+//
+//	reader := blanknode.NewPatchReader(r)
+//
+//	for {
+//		row, err := reader.Read()
+//		if io.EOF == err {
+//			break
+//		}
+//		if nil != err {
+//			return err
+//		}
+//
+//		// ...
+//	}
+type PatchReader struct {
+	scanner  *bufio.Scanner
+	prefixes map[string]string
+	line     int
+}
+
+// patchMaxLineSize is the longest line (in bytes) a PatchReader reads.
+// (A line can be long if it has a long literal.)
+const patchMaxLineSize = 256 * 1024 * 1024
+
+// NewPatchReader returns a new [PatchReader] that reads from ‘reader’.
+//
+// A line can be up to 256 MiB long.
+func NewPatchReader(reader io.Reader) *PatchReader {
+	var scanner *bufio.Scanner = bufio.NewScanner(reader)
+	scanner.Buffer(nil, patchMaxLineSize)
+
+	return &PatchReader{
+		scanner:  scanner,
+		prefixes: map[string]string{},
+	}
+}
+
+// Read reads the next row.
+//
+// Blank lines and comments (lines beginning with "#") are skipped.
+// At the end of the RDF Patch, Read returns [io.EOF].
+func (receiver *PatchReader) Read() (PatchRow, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	for receiver.scanner.Scan() {
+		receiver.line++
+
+		var line string = strings.TrimSpace(receiver.scanner.Text())
+		if "" == line || '#' == line[0] {
+			continue
+		}
+
+		row, err := receiver.parse(line)
+		if nil != err {
+			return PatchRow{}, erorr.Errorf("rdf-patch line %d: %w", receiver.line, err)
+		}
+
+		return row, nil
+	}
+	if err := receiver.scanner.Err(); nil != err {
+		return PatchRow{}, err
+	}
+
+	return PatchRow{}, io.EOF
+}
+
+func (receiver *PatchReader) parse(line string) (PatchRow, error) {
+	var row PatchRow
+
+	code, rest := patchWord(line)
+	row.Code = code
+
+	switch code {
+	case PatchHeader:
+		var name string
+		name, rest = patchWord(rest)
+		if "" == name {
+			return PatchRow{}, erorr.Errorf("missing header name: %w", ErrPatchRowMalformed)
+		}
+		row.Name = name
+	case PatchTransactionBegin, PatchTransactionCommit, PatchTransactionAbort, PatchPrefixAdd, PatchPrefixDelete, PatchAdd, PatchDelete:
+		// nothing here.
+	default:
+		return PatchRow{}, erorr.Errorf("unknown row code %q: %w", code, ErrPatchRowMalformed)
+	}
+
+	for {
+		rest = strings.TrimLeft(rest, " \t")
+		if "" == rest || "." == rest {
+			break
+		}
+
+		term, remaining, err := receiver.term(rest)
+		if nil != err {
+			return PatchRow{}, err
+		}
+		row.Terms = append(row.Terms, term)
+		rest = remaining
+	}
+
+	var expected []int
+	switch code {
+	case PatchHeader:
+		expected = []int{1}
+	case PatchTransactionBegin, PatchTransactionCommit, PatchTransactionAbort:
+		expected = []int{0}
+	case PatchPrefixAdd:
+		expected = []int{2}
+	case PatchPrefixDelete:
+		expected = []int{1}
+	case PatchAdd, PatchDelete:
+		expected = []int{3, 4}
+	}
+	var ok bool
+	for _, n := range expected {
+		if n == len(row.Terms) {
+			ok = true
+		}
+	}
+	if !ok {
+		return PatchRow{}, erorr.Errorf("%q row with %d terms: %w", code, len(row.Terms), ErrPatchRowMalformed)
+	}
+
+	switch code {
+	case PatchPrefixAdd:
+		prefix, found := patchLiteralValue(row.Terms[0])
+		if !found {
+			return PatchRow{}, erorr.Errorf("prefix is not a literal: %w", ErrPatchRowMalformed)
+		}
+		iri, found := patchLiteralValue(row.Terms[1])
+		if !found {
+			return PatchRow{}, erorr.Errorf("prefix IRI is not a literal: %w", ErrPatchRowMalformed)
+		}
+		receiver.prefixes[prefix] = iri
+	case PatchPrefixDelete:
+		prefix, found := patchLiteralValue(row.Terms[0])
+		if !found {
+			return PatchRow{}, erorr.Errorf("prefix is not a literal: %w", ErrPatchRowMalformed)
+		}
+		delete(receiver.prefixes, prefix)
+	}
+
+	return row, nil
+}
+
+// term parses the term at the beginning of ‘str’, and returns it and the rest of ‘str’.
+func (receiver *PatchReader) term(str string) (Term, string, error) {
+	switch {
+	case strings.HasPrefix(str, IdentifierPrefix):
+		var end int = strings.IndexAny(str, " \t")
+		if end < 0 {
+			end = len(str)
+		}
+		var label string = str[len(IdentifierPrefix):end]
+		var rest string = str[end:]
+
+		// A "." at the end of a blank-node-label is the end of the row.
+		if strings.HasSuffix(label, ".") {
+			label = label[:len(label)-1]
+			rest = " ." + rest
+		}
+
+		parsed, err := ParseLabelString(label)
+		if nil != err {
+			return Term{}, "", err
+		}
+
+		return BlankNodeTerm(someIdentifier(parsed)), rest, nil
+
+	case strings.HasPrefix(str, "<"):
+		var end int = strings.IndexByte(str, '>')
+		if end < 0 {
+			return Term{}, "", erorr.Errorf("unterminated IRI: %w", ErrPatchRowMalformed)
+		}
+		var iri string = str[:end+1]
+
+		// Some RDF Patch writers write blank-nodes as "<_:label>".
+		if strings.HasPrefix(iri, "<"+IdentifierPrefix) {
+			parsed, err := ParseLabelString(iri[len("<"+IdentifierPrefix) : len(iri)-1])
+			if nil != err {
+				return Term{}, "", err
+			}
+
+			return BlankNodeTerm(someIdentifier(parsed)), str[end+1:], nil
+		}
+
+		return GroundTerm(iri), str[end+1:], nil
+
+	case strings.HasPrefix(str, `"`):
+		var end int = -1
+		for index := 1; index < len(str); index++ {
+			switch str[index] {
+			case '\\':
+				index++
+			case '"':
+				end = index
+			}
+			if 0 <= end {
+				break
+			}
+		}
+		if end < 0 {
+			return Term{}, "", erorr.Errorf("unterminated literal: %w", ErrPatchRowMalformed)
+		}
+
+		var literal string = str[:end+1]
+		var rest string = str[end+1:]
+
+		switch {
+		case strings.HasPrefix(rest, "@"):
+			// LANGTAG ::= '@' [a-zA-Z]+ ('-' [a-zA-Z0-9]+)*
+			// (So a "." right after it is the end of the row.)
+			var length int = 1
+			for length < len(rest) && patchLanguageTagByte(rest[length]) {
+				length++
+			}
+			if length <= 1 {
+				return Term{}, "", erorr.Errorf("empty language tag: %w", ErrPatchRowMalformed)
+			}
+			literal += rest[:length]
+			rest = rest[length:]
+		case strings.HasPrefix(rest, "^^"):
+			datatype, remaining, err := receiver.term(rest[len("^^"):])
+			if nil != err {
+				return Term{}, "", err
+			}
+			if datatype.IsBlankNode() {
+				return Term{}, "", erorr.Errorf("datatype is a blank-node: %w", ErrPatchRowMalformed)
+			}
+			literal += "^^" + datatype.String()
+			rest = remaining
+		}
+
+		return GroundTerm(literal), rest, nil
+
+	default:
+		word, rest := patchWord(str)
+
+		// A "." at the end of a prefixed name is the end of the row.
+		if strings.HasSuffix(word, ".") {
+			word = word[:len(word)-1]
+			rest = " ." + rest
+		}
+
+		index := strings.IndexByte(word, ':')
+		if index < 0 {
+			return Term{}, "", erorr.Errorf("unexpected %q: %w", word, ErrPatchRowMalformed)
+		}
+
+		iri, found := receiver.prefixes[word[:index]]
+		if !found {
+			return Term{}, "", erorr.Errorf("unknown prefix %q: %w", word[:index], ErrPatchRowMalformed)
+		}
+
+		return GroundTerm("<" + iri + word[index+1:] + ">"), rest, nil
+	}
+}
+
+func patchLanguageTagByte(b byte) bool {
+	switch {
+	case 'A' <= b && b <= 'Z':
+		return true
+	case 'a' <= b && b <= 'z':
+		return true
+	case '0' <= b && b <= '9':
+		return true
+	case '-' == b:
+		return true
+	default:
+		return false
+	}
+}
+
+func patchWord(str string) (string, string) {
+	str = strings.TrimLeft(str, " \t")
+
+	var end int = strings.IndexAny(str, " \t")
+	if end < 0 {
+		return str, ""
+	}
+
+	return str[:end], str[end:]
+}
+
+// patchLiteralValue returns the value of a simple literal (without escapes), such as the prefix in a "PA" row.
+func patchLiteralValue(term Term) (string, bool) {
+	value, found := term.Ground()
+	if !found || len(value) < 2 || '"' != value[0] || '"' != value[len(value)-1] || strings.ContainsRune(value, '\\') {
+		return "", false
+	}
+
+	return value[1 : len(value)-1], true
+}
+
+// PatchWriter writes the rows of an RDF Patch.
+//
+// Blank-nodes are written as "_:label".
+type PatchWriter struct {
+	writer io.Writer
+}
+
+// NewPatchWriter returns a new [PatchWriter] that writes to ‘writer’.
+func NewPatchWriter(writer io.Writer) *PatchWriter {
+	return &PatchWriter{
+		writer: writer,
+	}
+}
+
+// Write writes a row.
+func (receiver *PatchWriter) Write(row PatchRow) error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	for _, term := range row.Terms {
+		if term.IsNothing() {
+			return erorr.Errorf("%q row: %w", row.Code, ErrEmptyTerm)
+		}
+	}
+
+	_, err := io.WriteString(receiver.writer, row.String()+"\n")
+	return err
+}
+
+// PatchScope maps the blank-nodes of an RDF Patch to the blank-nodes of a store.
+//
+// The blank-node-labels in an RDF Patch are scoped to the RDF Patch.
+// So the same blank-node-label must be mapped to the same blank-node of the store everywhere in the RDF Patch — and only in that RDF Patch.
+//
+// For example:
+//
+//	var scope blanknode.PatchScope = blanknode.PatchScope{
+//		Resolve: func(identifier blanknode.Identifier) (blanknode.Identifier, error) {
+//			return store.NewBlankNode()
+//		},
+//	}
+//
+//	// ...
+//
+//	row, err = scope.Map(row)
+//
+// Call [PatchScope.Reset] before the next RDF Patch.
+type PatchScope struct {
+	// Resolve is called the first time a blank-node-identifier is seen in the RDF Patch,
+	// and returns the blank-node-identifier of the store that it maps to.
+	//
+	// If Resolve is nil, then each blank-node-identifier maps to itself.
+	// (Which is what you want if the RDF Patch was written using the blank-node-labels of the store.)
+	Resolve func(Identifier) (Identifier, error)
+
+	identifiers map[Identifier]Identifier
+}
+
+// Map returns ‘row’ with its blank-nodes mapped to the blank-nodes of the store.
+func (receiver *PatchScope) Map(row PatchRow) (PatchRow, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	var terms []Term = make([]Term, len(row.Terms))
+	for index, term := range row.Terms {
+		identifier, isBlankNode := term.BlankNode()
+		if !isBlankNode {
+			terms[index] = term
+			continue
+		}
+
+		mapped, err := receiver.resolve(identifier)
+		if nil != err {
+			return PatchRow{}, err
+		}
+		terms[index] = BlankNodeTerm(mapped)
+	}
+
+	row.Terms = terms
+	return row, nil
+}
+
+// Reset forgets the mappings, so that the [PatchScope] can be used for another RDF Patch.
+func (receiver *PatchScope) Reset() {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	receiver.identifiers = nil
+}
+
+func (receiver *PatchScope) resolve(identifier Identifier) (Identifier, error) {
+	if mapped, found := receiver.identifiers[identifier]; found {
+		return mapped, nil
+	}
+
+	var mapped Identifier = identifier
+	if nil != receiver.Resolve {
+		var err error
+		mapped, err = receiver.Resolve(identifier)
+		if nil != err {
+			return Identifier{}, err
+		}
+		if mapped.IsNothing() {
+			return Identifier{}, erorr.Errorf("resolving %s: %w", identifier, ErrEmptyIdentifier)
+		}
+	}
+
+	if nil == receiver.identifiers {
+		receiver.identifiers = map[Identifier]Identifier{}
+	}
+	receiver.identifiers[identifier] = mapped
+
+	return mapped, nil
+}
@@ -0,0 +1,324 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"io"
+	"strings"
+)
+
+func TestPatchReader(t *testing.T) {
+	const patch =
+		"# A comment.\n" +
+		"H id <uuid:0686c69d-8f89-4496-acb5-744f0157a8db> .\n" +
+		"\n" +
+		"TX .\n" +
+		"PA \"ex\" \"http://example.com/\" .\n" +
+		"A _:b0 <http://example.com/name> \"Joe\" .\n" +
+		"A _:b0 ex:age \"42\"^^<http://www.w3.org/2001/XMLSchema#integer> .\n" +
+		"A <_:b1> ex:name \"Jöe\"@fr <http://example.com/graph> .\n" +
+		"D <http://example.com/joe> ex:knows _:b0.\n" +
+		"A _:b0 ex:name \"Joe\"@en.\n" +
+		"A _:b0 ex:name \"Joe\"@en-GB <http://example.com/graph>.\n" +
+		"PD \"ex\" .\n" +
+		"TC .\n"
+
+	expected := []string{
+		`H id <uuid:0686c69d-8f89-4496-acb5-744f0157a8db> .`,
+		`TX .`,
+		`PA "ex" "http://example.com/" .`,
+		`A _:b0 <http://example.com/name> "Joe" .`,
+		`A _:b0 <http://example.com/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .`,
+		`A _:b1 <http://example.com/name> "Jöe"@fr <http://example.com/graph> .`,
+		`D <http://example.com/joe> <http://example.com/knows> _:b0 .`,
+		`A _:b0 <http://example.com/name> "Joe"@en .`,
+		`A _:b0 <http://example.com/name> "Joe"@en-GB <http://example.com/graph> .`,
+		`PD "ex" .`,
+		`TC .`,
+	}
+
+	reader := NewPatchReader(strings.NewReader(patch))
+
+	var actual []string
+	for {
+		row, err := reader.Read()
+		if io.EOF == err {
+			break
+		}
+		if nil != err {
+			t.Errorf("Did not expect an error but actually got one.")
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+
+		actual = append(actual, row.String())
+	}
+
+	if len(expected) != len(actual) {
+		t.Errorf("The actual number of rows is not what was expected.")
+		t.Logf("EXPECTED: %d", len(expected))
+		t.Logf("ACTUAL:   %d", len(actual))
+		for _, line := range actual {
+			t.Logf("ROW: %s", line)
+		}
+		return
+	}
+
+	for index, line := range expected {
+		if line != actual[index] {
+			t.Errorf("For row #%d, the actual row is not what was expected.", index)
+			t.Logf("EXPECTED: %s", line)
+			t.Logf("ACTUAL:   %s", actual[index])
+		}
+	}
+}
+
+func TestPatchReader_longLiteral(t *testing.T) {
+	// Longer than the 64 KiB that a bufio.Scanner reads by default.
+	var value string = strings.Repeat("x", 1024*1024)
+
+	reader := NewPatchReader(strings.NewReader("A _:b0 <http://example.com/name> \"" + value + "\" .\n"))
+
+	row, err := reader.Read()
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if expected, actual := 3, len(row.Terms); expected != actual {
+		t.Errorf("The actual number of terms is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+		return
+	}
+
+	if expected, actual := `"`+value+`"`, row.Terms[2].String(); expected != actual {
+		t.Errorf("The actual literal is not what was expected.")
+		t.Logf("EXPECTED: (%d bytes)", len(expected))
+		t.Logf("ACTUAL:   (%d bytes)", len(actual))
+		return
+	}
+}
+
+func TestPatchReader_errors(t *testing.T) {
+	tests := []struct{
+		Patch string
+	}{
+		{
+			Patch: "X .\n",
+		},
+		{
+			Patch: "A <http://example.com/a> <http://example.com/b> .\n",
+		},
+		{
+			Patch: "A <http://example.com/a> <http://example.com/b> \"c .\n",
+		},
+		{
+			Patch: "A <http://example.com/a> ex:b <http://example.com/c> .\n",
+		},
+		{
+			Patch: "A _:-a <http://example.com/b> <http://example.com/c> .\n",
+		},
+		{
+			Patch: "TX <http://example.com/a> .\n",
+		},
+		{
+			Patch: "H .\n",
+		},
+		{
+			Patch: "A <http://example.com/a> <http://example.com/b> \"c\"@ .\n",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := NewPatchReader(strings.NewReader(test.Patch)).Read()
+		if nil == err || io.EOF == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("PATCH: %q", test.Patch)
+			continue
+		}
+	}
+}
+
+func TestPatchWriter(t *testing.T) {
+	var builder strings.Builder
+	writer := NewPatchWriter(&builder)
+
+	rows := []PatchRow{
+		PatchRow{Code:PatchTransactionBegin},
+		PatchRowForQuad(PatchAdd, msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("name"), GroundTerm(`"Joe"`))),
+		PatchRowForQuad(PatchDelete, Quad{
+			Subject:   msgTestIRI("joe"),
+			Predicate: msgTestIRI("knows"),
+			Object:    msgTestBlankNode("b1"),
+			Graph:     msgTestIRI("graph"),
+		}),
+		PatchRow{Code:PatchTransactionCommit},
+	}
+
+	for _, row := range rows {
+		if err := writer.Write(row); nil != err {
+			t.Errorf("Did not expect an error but actually got one.")
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+	}
+
+	expected :=
+		"TX .\n" +
+		"A _:b0 <http://example.com/name> \"Joe\" .\n" +
+		"D <http://example.com/joe> <http://example.com/knows> _:b1 <http://example.com/graph> .\n" +
+		"TC .\n"
+
+	actual := builder.String()
+
+	if expected != actual {
+		t.Errorf("The actual RDF Patch is not what was expected.")
+		t.Logf("EXPECTED:\n%s", expected)
+		t.Logf("ACTUAL:\n%s", actual)
+		return
+	}
+
+	// Round trip.
+	{
+		reader := NewPatchReader(strings.NewReader(actual))
+
+		for index, row := range rows {
+			readRow, err := reader.Read()
+			if nil != err {
+				t.Errorf("For row #%d, did not expect an error but actually got one.", index)
+				t.Logf("ERROR: (%T) %s", err, err)
+				return
+			}
+
+			if row.String() != readRow.String() {
+				t.Errorf("For row #%d, the actual row is not what was expected.", index)
+				t.Logf("EXPECTED: %s", row)
+				t.Logf("ACTUAL:   %s", readRow)
+			}
+		}
+	}
+}
+
+func TestPatchScope(t *testing.T) {
+	var resolved []string
+	var count int
+
+	scope := PatchScope{
+		Resolve: func(identifier Identifier) (Identifier, error) {
+			resolved = append(resolved, identifier.String())
+			count++
+			return someIdentifier(someLabel("store" + string(rune('0'+count)))), nil
+		},
+	}
+
+	const patch =
+		"TX .\n" +
+		"A _:x <http://example.com/p> _:y .\n" +
+		"A _:y <http://example.com/p> _:x .\n" +
+		"D <http://example.com/a> <http://example.com/p> _:x .\n" +
+		"TC .\n"
+
+	var actual []string
+	reader := NewPatchReader(strings.NewReader(patch))
+	for {
+		row, err := reader.Read()
+		if io.EOF == err {
+			break
+		}
+		if nil != err {
+			t.Errorf("Did not expect an error but actually got one.")
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+
+		mapped, err := scope.Map(row)
+		if nil != err {
+			t.Errorf("Did not expect an error but actually got one.")
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+
+		actual = append(actual, mapped.String())
+	}
+
+	expected := []string{
+		`TX .`,
+		`A _:store1 <http://example.com/p> _:store2 .`,
+		`A _:store2 <http://example.com/p> _:store1 .`,
+		`D <http://example.com/a> <http://example.com/p> _:store1 .`,
+		`TC .`,
+	}
+
+	if strings.Join(expected, "\n") != strings.Join(actual, "\n") {
+		t.Errorf("The actual mapped rows are not what was expected.")
+		t.Logf("EXPECTED:\n%s", strings.Join(expected, "\n"))
+		t.Logf("ACTUAL:\n%s", strings.Join(actual, "\n"))
+	}
+
+	if expected, actual := "_:x _:y", strings.Join(resolved, " "); expected != actual {
+		t.Errorf("The actual resolved blank-nodes are not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+	}
+
+	// A new RDF Patch gets new mappings.
+	scope.Reset()
+	{
+		row := PatchRowForQuad(PatchAdd, msgTestQuad(msgTestBlankNode("x"), msgTestIRI("p"), msgTestIRI("o")))
+
+		mapped, err := scope.Map(row)
+		if nil != err {
+			t.Errorf("Did not expect an error but actually got one.")
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+
+		if expected, actual := `A _:store3 <http://example.com/p> <http://example.com/o> .`, mapped.String(); expected != actual {
+			t.Errorf("The actual mapped row is not what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+		}
+	}
+}
+
+func TestPatchScope_resolveError(t *testing.T) {
+	var errResolve = errors.New("cannot resolve")
+
+	scope := PatchScope{
+		Resolve: func(Identifier) (Identifier, error) {
+			return Identifier{}, errResolve
+		},
+	}
+
+	row := PatchRowForQuad(PatchAdd, msgTestQuad(msgTestBlankNode("x"), msgTestIRI("p"), msgTestIRI("o")))
+
+	_, err := scope.Map(row)
+	if !errors.Is(err, errResolve) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", errResolve)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func TestPatchScope_nilResolve(t *testing.T) {
+	var scope PatchScope
+
+	row := PatchRowForQuad(PatchAdd, msgTestQuad(msgTestBlankNode("x"), msgTestIRI("p"), msgTestIRI("o")))
+
+	mapped, err := scope.Map(row)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if expected, actual := row.String(), mapped.String(); expected != actual {
+		t.Errorf("The actual mapped row is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+	}
+}
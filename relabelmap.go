@@ -0,0 +1,87 @@
+package blanknode
+
+import (
+	"strconv"
+	"sync"
+)
+
+// RelabelMap maps blank-node-identifiers to new blank-node-identifiers.
+//
+// It is lookup-or-assign:
+// the first time a blank-node-identifier is relabeled it is assigned a new blank-node-identifier,
+// and after that it is always relabeled to that same new blank-node-identifier.
+//
+// For example:
+//
+//	var relabelMap blanknode.RelabelMap = new(blanknode.MemoryRelabelMap)
+//
+//	// ...
+//
+//	relabeled, err := relabelMap.Relabel(identifier)
+//
+// See [MemoryRelabelMap] and [DiskRelabelMap].
+type RelabelMap interface {
+	Relabel(Identifier) (Identifier, error)
+}
+
+var (
+	_ RelabelMap = &MemoryRelabelMap{}
+	_ RelabelMap = &DiskRelabelMap{}
+)
+
+// relabelMapIdentifier returns the n-th new blank-node-identifier that a [RelabelMap] assigns — i.e., "_:b" followed by ‘n’.
+func relabelMapIdentifier(n uint64) Identifier {
+	return someIdentifier(someLabel("b" + strconv.FormatUint(n, 10)))
+}
+
+// MemoryRelabelMap is a [RelabelMap] that keeps everything in memory.
+//
+// The new blank-node-identifiers are "_:b0", "_:b1", "_:b2", etc, in the order the blank-node-identifiers are first relabeled.
+//
+// The zero value is ready to use.
+// It is safe to use from multiple goroutines.
+//
+// Every blank-node-identifier that is relabeled stays in memory.
+// For (very) large numbers of blank-nodes, see [DiskRelabelMap].
+type MemoryRelabelMap struct {
+	mutex       sync.Mutex
+	identifiers map[Identifier]Identifier
+}
+
+// Len returns how many blank-node-identifiers have been relabeled.
+func (receiver *MemoryRelabelMap) Len() int {
+	if nil == receiver {
+		return 0
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	return len(receiver.identifiers)
+}
+
+// Relabel returns the new blank-node-identifier for ‘identifier’, assigning one if ‘identifier’ has not been relabeled before.
+func (receiver *MemoryRelabelMap) Relabel(identifier Identifier) (Identifier, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+	if identifier.IsNothing() {
+		return Identifier{}, ErrEmptyIdentifier
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	if relabeled, found := receiver.identifiers[identifier]; found {
+		return relabeled, nil
+	}
+
+	if nil == receiver.identifiers {
+		receiver.identifiers = map[Identifier]Identifier{}
+	}
+
+	var relabeled Identifier = relabelMapIdentifier(uint64(len(receiver.identifiers)))
+	receiver.identifiers[identifier] = relabeled
+
+	return relabeled, nil
+}
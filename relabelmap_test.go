@@ -0,0 +1,231 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+func relabelMapTest(t *testing.T, relabelMap RelabelMap) {
+	t.Helper()
+
+	// Enough blank-node-identifiers that the on-disk hash table has to grow (a few times).
+	const count = 20000
+
+	// Each blank-node-identifier is relabeled more than once, in a different order each time.
+	for round := 0; round < 3; round++ {
+		for n := 0; n < count; n++ {
+			var i int = n
+			if 1 == round {
+				i = count - 1 - n
+			}
+			if 2 == round {
+				i = (n * 7919) % count
+			}
+
+			identifier := someIdentifier(someLabel("x" + strconv.Itoa(i)))
+
+			actual, err := relabelMap.Relabel(identifier)
+			if nil != err {
+				t.Errorf("For round #%d and blank-node-identifier %s, did not expect an error but actually got one.", round, identifier)
+				t.Logf("ERROR: (%T) %s", err, err)
+				return
+			}
+
+			expected := someIdentifier(someLabel("b" + strconv.Itoa(i)))
+
+			if expected != actual {
+				t.Errorf("For round #%d, the actual relabeled blank-node-identifier for %s is not what was expected.", round, identifier)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				return
+			}
+		}
+	}
+
+	if _, err := relabelMap.Relabel(NoIdentifier()); !errors.Is(err, ErrEmptyIdentifier) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrEmptyIdentifier)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func TestMemoryRelabelMap(t *testing.T) {
+	var relabelMap MemoryRelabelMap
+
+	relabelMapTest(t, &relabelMap)
+
+	if expected, actual := 20000, relabelMap.Len(); expected != actual {
+		t.Errorf("The actual length is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+}
+
+func TestDiskRelabelMap(t *testing.T) {
+	relabelMap, err := OpenDiskRelabelMap(t.TempDir())
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	defer relabelMap.Close()
+
+	relabelMapTest(t, relabelMap)
+
+	if expected, actual := 20000, relabelMap.Len(); expected != actual {
+		t.Errorf("The actual length is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+
+	if err := relabelMap.Close(); nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if _, err := relabelMap.Relabel(someIdentifier(someLabel("x0"))); !errors.Is(err, ErrClosed) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrClosed)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func TestDiskRelabelMap_hashCollision(t *testing.T) {
+	relabelMap, err := OpenDiskRelabelMap(t.TempDir())
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	defer relabelMap.Close()
+
+	// Put a different blank-node-label with the same hash (and length) in the slot that "_:x0" would go in.
+	var label string = "_:x0"
+	var hash uint64 = diskRelabelMapHash(label)
+	{
+		var other string = "_:y0"
+		if _, err := relabelMap.insert(hash&(relabelMap.capacity-1), hash, other); nil != err {
+			t.Errorf("Did not expect an error but actually got one.")
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+	}
+
+	actual, err := relabelMap.Relabel(someIdentifier(someLabel("x0")))
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if expected := someIdentifier(someLabel("b1")); expected != actual {
+		t.Errorf("The actual relabeled blank-node-identifier is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+	}
+}
+
+func TestDiskRelabelMap_lookupWrapsAround(t *testing.T) {
+	relabelMap, err := OpenDiskRelabelMap(t.TempDir())
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	defer relabelMap.Close()
+
+	// Fill the last slot, so that probing from it has to wrap around to the first slot.
+	var hash uint64 = relabelMap.capacity - 1
+	if _, err := relabelMap.insert(hash, hash, "y0"); nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	index, _, found, err := relabelMap.lookup(hash, "x0")
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	if found {
+		t.Errorf("Did not expect the blank-node-label to be found, but it actually was.")
+	}
+	if expected, actual := uint64(0), index; expected != actual {
+		t.Errorf("The actual index of the empty slot is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+
+	_, value, found, err := relabelMap.lookup(hash, "y0")
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	if !found || 0 != value {
+		t.Errorf("Expected the blank-node-label to be found (as #0), but it actually was not.")
+		t.Logf("FOUND: %t", found)
+		t.Logf("VALUE: %d", value)
+	}
+}
+
+func TestDiskRelabelMap_growError(t *testing.T) {
+	var directory string = filepath.Join(t.TempDir(), "relabel")
+	if err := os.Mkdir(directory, 0755); nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	relabelMap, err := OpenDiskRelabelMap(directory)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+	defer relabelMap.Close()
+
+	// Fill the hash table up to where the next new blank-node-identifier makes it grow.
+	var full int = int(relabelMap.capacity / 2)
+	for i := 0; i < full; i++ {
+		if _, err := relabelMap.Relabel(someIdentifier(someLabel("x" + strconv.Itoa(i)))); nil != err {
+			t.Fatalf("Did not expect an error for #%d but actually got one: (%T) %s", i, err, err)
+		}
+	}
+
+	// Without the directory, the hash table cannot grow (since the bigger one cannot be created).
+	if err := os.RemoveAll(directory); nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		if _, err := relabelMap.Relabel(someIdentifier(someLabel("new"))); nil == err {
+			t.Errorf("For attempt #%d, expected an error but did not actually get one.", attempt)
+		}
+
+		if expected, actual := full, relabelMap.Len(); expected != actual {
+			t.Errorf("For attempt #%d, the actual length is not what was expected.", attempt)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+		}
+	}
+
+	// Blank-node-identifiers that were already relabeled are still there.
+	for _, i := range []int{0, 1, full - 1} {
+		actual, err := relabelMap.Relabel(someIdentifier(someLabel("x" + strconv.Itoa(i))))
+		if nil != err {
+			t.Errorf("For #%d, did not expect an error but actually got one.", i)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+		if expected := relabelMapIdentifier(uint64(i)); expected != actual {
+			t.Errorf("For #%d, the actual relabeled blank-node-identifier is not what was expected.", i)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package blanknode
+
+import (
+	"slices"
+	"strconv"
+)
+
+// RelabelStable relabels the blank-nodes in ‘current’, reusing the blank-node-identifiers from ‘previous’ where it can.
+//
+// This is useful when exporting an RDF graph over and over again (for example, nightly).
+// Without it, each export labels its blank-nodes from scratch, so even parts of the RDF graph that did not change end up with different blank-node-labels, and diffs between exports are huge.
+//
+// ‘previous’ is the previous export (with its blank-node-labels), and ‘current’ is the RDF graph to export now.
+//
+// A blank-node in ‘current’ keeps the blank-node-identifier it had in ‘previous’ if the structure around it is unchanged:
+//
+// • if its whole Minimum Self-contained Graph (see [MSGs]) is the same (other than how the blank-nodes are labeled), or
+//
+// • if the quads it is in are the same (other than how the other blank-nodes are labeled).
+//
+// Every other blank-node is given a fresh blank-node-identifier: "_:b" followed by a number.
+// A fresh blank-node-identifier is never one that was used in ‘previous’.
+//
+// RelabelStable returns the relabeled quads (sorted by their N-Quads statement, with duplicates removed), and the map from each blank-node-identifier in ‘current’ to its new blank-node-identifier.
+func RelabelStable(previous []Quad, current []Quad) ([]Quad, map[Identifier]Identifier) {
+	var relabeling map[Identifier]Identifier = alignBlankNodes(previous, current, 1)
+
+	var used map[Identifier]struct{} = map[Identifier]struct{}{}
+	for _, identifier := range quadsBlankNodes(previous) {
+		used[identifier] = struct{}{}
+	}
+
+	var next int
+	for _, identifier := range quadsBlankNodes(current) {
+		if _, found := relabeling[identifier]; found {
+			continue
+		}
+
+		for {
+			var fresh Identifier = someIdentifier(someLabel("b" + strconv.Itoa(next)))
+			next++
+
+			if _, found := used[fresh]; !found {
+				used[fresh] = struct{}{}
+				relabeling[identifier] = fresh
+				break
+			}
+		}
+	}
+
+	var mapping map[Identifier]Term = map[Identifier]Term{}
+	for from, to := range relabeling {
+		mapping[from] = BlankNodeTerm(to)
+	}
+
+	var quads []Quad = mapQuads(current, mapping)
+	slices.SortFunc(quads, compareQuads)
+
+	return quads, relabeling
+}
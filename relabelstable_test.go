@@ -0,0 +1,92 @@
+package blanknode
+
+import (
+	"testing"
+
+	"slices"
+)
+
+func TestRelabelStable(t *testing.T) {
+	previous := []Quad{
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b0")),
+		msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+		msgTestQuad(msgTestIRI("jane"), msgTestIRI("address"), msgTestBlankNode("b1")),
+		msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("city"), GroundTerm(`"Victoria"`)),
+		msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("geo"), msgTestBlankNode("b2")),
+		msgTestQuad(msgTestBlankNode("b2"), msgTestIRI("lat"), GroundTerm(`"48.43"`)),
+		msgTestQuad(msgTestIRI("joan"), msgTestIRI("address"), msgTestBlankNode("b3")),
+		msgTestQuad(msgTestBlankNode("b3"), msgTestIRI("city"), GroundTerm(`"Surrey"`)),
+	}
+
+	// Exported from scratch, so all the blank-node-labels are different.
+	current := []Quad{
+		// Unchanged.
+		msgTestQuad(msgTestIRI("joe"), msgTestIRI("address"), msgTestBlankNode("b1")),
+		msgTestQuad(msgTestBlankNode("b1"), msgTestIRI("city"), GroundTerm(`"Vancouver"`)),
+
+		// The address is unchanged, but the geo changed.
+		msgTestQuad(msgTestIRI("jane"), msgTestIRI("address"), msgTestBlankNode("b0")),
+		msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("city"), GroundTerm(`"Victoria"`)),
+		msgTestQuad(msgTestBlankNode("b0"), msgTestIRI("geo"), msgTestBlankNode("b2")),
+		msgTestQuad(msgTestBlankNode("b2"), msgTestIRI("lat"), GroundTerm(`"48.42"`)),
+
+		// New.
+		msgTestQuad(msgTestIRI("jim"), msgTestIRI("address"), msgTestBlankNode("b3")),
+		msgTestQuad(msgTestBlankNode("b3"), msgTestIRI("city"), GroundTerm(`"Burnaby"`)),
+	}
+
+	quads, relabeling := RelabelStable(previous, current)
+
+	{
+		expected := map[string]string{
+			"_:b1": "_:b0",
+			"_:b0": "_:b1",
+			"_:b2": "_:b4",
+			"_:b3": "_:b5",
+		}
+
+		var actual map[string]string = map[string]string{}
+		for from, to := range relabeling {
+			actual[from.String()] = to.String()
+		}
+
+		if len(expected) != len(actual) {
+			t.Errorf("The actual relabeling is not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			return
+		}
+		for key, value := range expected {
+			if value != actual[key] {
+				t.Errorf("The actual relabeling of %q is not what was expected.", key)
+				t.Logf("EXPECTED: %q", value)
+				t.Logf("ACTUAL:   %q", actual[key])
+				continue
+			}
+		}
+	}
+
+	{
+		expected := []string{
+			`<http://example.com/jane> <http://example.com/address> _:b1 .`,
+			`<http://example.com/jim> <http://example.com/address> _:b5 .`,
+			`<http://example.com/joe> <http://example.com/address> _:b0 .`,
+			`_:b0 <http://example.com/city> "Vancouver" .`,
+			`_:b1 <http://example.com/city> "Victoria" .`,
+			`_:b1 <http://example.com/geo> _:b4 .`,
+			`_:b4 <http://example.com/lat> "48.42" .`,
+			`_:b5 <http://example.com/city> "Burnaby" .`,
+		}
+
+		var actual []string
+		for _, quad := range quads {
+			actual = append(actual, quad.String())
+		}
+
+		if !slices.Equal(expected, actual) {
+			t.Errorf("The actual relabeled quads are not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+		}
+	}
+}
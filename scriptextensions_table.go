@@ -0,0 +1,1451 @@
+// Code generated by gen_scriptextensions.go from testdata/unicode/Scripts.txt and testdata/unicode/ScriptExtensions.txt; DO NOT EDIT.
+
+package blanknode
+
+// scriptExtensionsVersion is the version of the Unicode Script_Extensions data in scriptExtensionRanges.
+const scriptExtensionsVersion = "15.0.0"
+
+// scriptSetWords is how many uint64 a scriptSet needs, for a bit for each of scriptNames.
+const scriptSetWords = 3
+
+// scriptUnknown is the bit (in a scriptSet) for Unknown ("Zzzz") — i.e., an unassigned code point.
+const scriptUnknown = 164
+
+// scriptNames are the ISO 15924 names of the scripts, in the order of their bits in a scriptSet.
+var scriptNames = [...]string{
+	"Adlm",
+	"Aghb",
+	"Ahom",
+	"Arab",
+	"Armi",
+	"Armn",
+	"Avst",
+	"Bali",
+	"Bamu",
+	"Bass",
+	"Batk",
+	"Beng",
+	"Bhks",
+	"Bopo",
+	"Brah",
+	"Brai",
+	"Bugi",
+	"Buhd",
+	"Cakm",
+	"Cans",
+	"Cari",
+	"Cham",
+	"Cher",
+	"Chrs",
+	"Copt",
+	"Cpmn",
+	"Cprt",
+	"Cyrl",
+	"Deva",
+	"Diak",
+	"Dogr",
+	"Dsrt",
+	"Dupl",
+	"Egyp",
+	"Elba",
+	"Elym",
+	"Ethi",
+	"Geor",
+	"Glag",
+	"Gong",
+	"Gonm",
+	"Goth",
+	"Gran",
+	"Grek",
+	"Gujr",
+	"Guru",
+	"Hanb",
+	"Hang",
+	"Hani",
+	"Hano",
+	"Hatr",
+	"Hebr",
+	"Hira",
+	"Hluw",
+	"Hmng",
+	"Hmnp",
+	"Hung",
+	"Ital",
+	"Java",
+	"Jpan",
+	"Kali",
+	"Kana",
+	"Kawi",
+	"Khar",
+	"Khmr",
+	"Khoj",
+	"Kits",
+	"Knda",
+	"Kore",
+	"Kthi",
+	"Lana",
+	"Laoo",
+	"Latn",
+	"Lepc",
+	"Limb",
+	"Lina",
+	"Linb",
+	"Lisu",
+	"Lyci",
+	"Lydi",
+	"Mahj",
+	"Maka",
+	"Mand",
+	"Mani",
+	"Marc",
+	"Medf",
+	"Mend",
+	"Merc",
+	"Mero",
+	"Mlym",
+	"Modi",
+	"Mong",
+	"Mroo",
+	"Mtei",
+	"Mult",
+	"Mymr",
+	"Nagm",
+	"Nand",
+	"Narb",
+	"Nbat",
+	"Newa",
+	"Nkoo",
+	"Nshu",
+	"Ogam",
+	"Olck",
+	"Orkh",
+	"Orya",
+	"Osge",
+	"Osma",
+	"Ougr",
+	"Palm",
+	"Pauc",
+	"Perm",
+	"Phag",
+	"Phli",
+	"Phlp",
+	"Phnx",
+	"Plrd",
+	"Prti",
+	"Rjng",
+	"Rohg",
+	"Runr",
+	"Samr",
+	"Sarb",
+	"Saur",
+	"Sgnw",
+	"Shaw",
+	"Shrd",
+	"Sidd",
+	"Sind",
+	"Sinh",
+	"Sogd",
+	"Sogo",
+	"Sora",
+	"Soyo",
+	"Sund",
+	"Sylo",
+	"Syrc",
+	"Tagb",
+	"Takr",
+	"Tale",
+	"Talu",
+	"Taml",
+	"Tang",
+	"Tavt",
+	"Telu",
+	"Tfng",
+	"Tglg",
+	"Thaa",
+	"Thai",
+	"Tibt",
+	"Tirh",
+	"Tnsa",
+	"Toto",
+	"Ugar",
+	"Vaii",
+	"Vith",
+	"Wara",
+	"Wcho",
+	"Xpeo",
+	"Xsux",
+	"Yezi",
+	"Yiii",
+	"Zanb",
+	"Zzzz",
+}
+
+// scriptSets are the (augmented) Script_Extensions sets, indexed by scriptExtensionRange.set.
+// Set 0 is all scripts — for Common and Inherited.
+var scriptSets = [...]scriptSet{
+	{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0x0000001FFFFFFFFF},
+	{0x0000000000000000, 0x0000000000000100, 0x0000000000000000},
+	{0x0000400000002000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000080000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000001000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000008000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000008000000, 0x0001000000000000, 0x0000000000000000},
+	{0x0000004008000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000008000000, 0x0000000000000100, 0x0000000000000000},
+	{0x0000000000000020, 0x0000000000000000, 0x0000000000000000},
+	{0x0008000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000008, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000008, 0x0100002000000000, 0x0000000200100200},
+	{0x0000000000000008, 0x0000000000000000, 0x0000000000100200},
+	{0x0000000000000009, 0x0100002000000000, 0x0000000200100200},
+	{0x0000000000000009, 0x01082000000C0000, 0x0000000000000208},
+	{0x0000000000000008, 0x0000000000000000, 0x0000000000000200},
+	{0x0000000000000008, 0x0000000000000000, 0x0000000200100000},
+	{0x0000000000000008, 0x0100000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000200},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000100000},
+	{0x0000000000000000, 0x0000002000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0400000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000040000, 0x0000000000000000},
+	{0x0000000010000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000340010000800, 0x8000040002000108, 0x0000000000824000},
+	{0x0000340010000800, 0x0000040002000108, 0x0000000000824000},
+	{0x0000358050000800, 0x0000040202010008, 0x0000000000824906},
+	{0x0000358050000800, 0x0000040202010408, 0x0000000000824906},
+	{0x0000000050000000, 0x0000000000010020, 0x0000000000000000},
+	{0x0000000000000800, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000040800, 0x0000000000000000, 0x0000000000000100},
+	{0x0000200000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000200000000000, 0x0000000040000000, 0x0000000000000000},
+	{0x0000100000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000100000000000, 0x0000000000000002, 0x0000000000000000},
+	{0x0000000000000000, 0x0000040000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000004000},
+	{0x0000040000000000, 0x0000000000000000, 0x0000000000004000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000020000},
+	{0x0000000000000000, 0x0000000000000008, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000200000008, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000002000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000004},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000200000},
+	{0x0000000000000000, 0x0000000000000080, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000400000},
+	{0x0000000000000000, 0x0000000080000000, 0x0000000000000000},
+	{0x0000000000040000, 0x0000000080000000, 0x0000000000001000},
+	{0x0000002000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000002000000000, 0x0000000000000100, 0x0000000000000000},
+	{0x0000800000000000, 0x0000000000000010, 0x0000000000000000},
+	{0x0000001000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000400000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000080000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000008000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0200000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000080000},
+	{0x0002000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0002000000020000, 0x0000000000000000, 0x0000000000080400},
+	{0x0000000000020000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000400},
+	{0x0000000000000000, 0x0000000000000001, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000008000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0002000008000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000400, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000001000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000002000},
+	{0x0000000000010000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000040, 0x0000000000000000},
+	{0x0000000000000080, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000080},
+	{0x0000000000000400, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000200, 0x0000000000000000},
+	{0x0000000000000000, 0x0000010000000000, 0x0000000000000000},
+	{0x0000040010000800, 0x0000000000000008, 0x0000000000000000},
+	{0x0000040010000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000010000800, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000010000000, 0x8000000000000000, 0x0000000000000000},
+	{0x0000000010000000, 0x0000040002000008, 0x0000000000024000},
+	{0x0000000010000000, 0x0000000200000000, 0x0000000000000000},
+	{0x0000040010000800, 0x0000040200000008, 0x0000000000820000},
+	{0x0000040010000000, 0x0000000000000008, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000200000000, 0x0000000000000000},
+	{0x0000000008000000, 0x0000000000000000, 0x0000000000000200},
+	{0x0000000000000000, 0x0000000008000100, 0x0000000000000000},
+	{0x0000040010000000, 0x0000000000000100, 0x0000000000000000},
+	{0x0000000000008000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000004000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000040000},
+	{0x0801400000000000, 0x0000000000000010, 0x0000000000000000},
+	{0x2811C00000002000, 0x0000000000000010, 0x0000000400000000},
+	{0x2811C00000002000, 0x0000000000000010, 0x0000000000000000},
+	{0x0801400000002000, 0x0000000000000010, 0x0000000000000000},
+	{0x2810000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x2811400000000000, 0x0000000000000010, 0x0000000000000000},
+	{0x0810000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x2800000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000400000000},
+	{0x0000000000000000, 0x0000000000002000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000008000000},
+	{0x0000000000000100, 0x0000000000000000, 0x0000000000000000},
+	{0x0801400000000000, 0x0000000000000110, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000100},
+	{0x0000300050000000, 0x000000020601002A, 0x0000000000800802},
+	{0x0000300050000000, 0x000000020401002A, 0x0000000000800802},
+	{0x0000300050000000, 0x0000000004010022, 0x0000000000800802},
+	{0x0000000000000000, 0x0002000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x1000000000000000, 0x0000000000000000},
+	{0x0000000010000000, 0x0000000000000000, 0x0000000000004000},
+	{0x1000000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x1000000000000000, 0x0000000080000100, 0x0000000000000000},
+	{0x0000000000000000, 0x0080000000000000, 0x0000000000000000},
+	{0x0400000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0400000000010000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000200000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000010000},
+	{0x0000000000000000, 0x0000000020000000, 0x0000000000000000},
+	{0x0000000000000008, 0x0000002000000000, 0x0000000000000000},
+	{0x0000000000000008, 0x0000000000000000, 0x0000000000100000},
+	{0x0000000000000000, 0x0000000000001000, 0x0000000000000000},
+	{0x0000000006000000, 0x0000000000001000, 0x0000000000000000},
+	{0x0000000004000000, 0x0000000000001000, 0x0000000000000000},
+	{0x0000000004000000, 0x0000000000001800, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000004000, 0x0000000000000000},
+	{0x0000000000100000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000001000008, 0x0000000000000000, 0x0000000000000000},
+	{0x0200000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000020000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0001000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000004000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000080000000},
+	{0x0000000080000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x4000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000100000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000080000000000, 0x0000000000000000},
+	{0x0000000400000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000002, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000010000000},
+	{0x0000000000000000, 0x0000000000000800, 0x0000000000000000},
+	{0x0000000004000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000010, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000400000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000800000000, 0x0000000000000000},
+	{0x0004000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0010000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000008000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000001000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000800000, 0x0000000000000000},
+	{0x8000000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0800000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000400000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000080000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000200000080000, 0x0000000000000000},
+	{0x0000000000000040, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0040000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0004000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0008000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000020000000000, 0x0000000000000000},
+	{0x0100000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0100000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000200000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000010},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000008},
+	{0x0000000000000000, 0x0000200000000000, 0x0000000000000000},
+	{0x0000000000800000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000800000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000004000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000020, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000020},
+	{0x0000000000040000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000010000, 0x0000000000000000},
+	{0x0000000000000000, 0x8000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000002, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000040000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000002},
+	{0x0000040000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000001000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000800000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000001},
+	{0x0000000000000000, 0x0000000004000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000800},
+	{0x0000000000000004, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000040000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000020000000},
+	{0x0000000020000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000800000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000000040},
+	{0x0000000000000000, 0x0000800000000000, 0x0000000000000000},
+	{0x0000000000001000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000100000, 0x0000000000000000},
+	{0x0000010000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000008000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000020000, 0x0000000000000000},
+	{0x4000000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000100000000},
+	{0x0000000002000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000200000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0020000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000010000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000001000000},
+	{0x0000000000000200, 0x0000000000000000, 0x0000000000000000},
+	{0x0040000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000200000, 0x0000000000000000},
+	{0x0000000000000000, 0x0020000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000000008000},
+	{0x0000000000000000, 0x0000004000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000004, 0x0000000000000000},
+	{0x0000000100000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x2000000000000000, 0x0000000000000000},
+	{0x0080000000000000, 0x0000000000000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000002000000},
+	{0x0000000000000000, 0x0000000000000000, 0x0000000040000000},
+	{0x0000000000000000, 0x0000000100000000, 0x0000000000000000},
+	{0x0000000000000000, 0x0000000000400000, 0x0000000000000000},
+	{0x0000000000000001, 0x0000000000000000, 0x0000000000000000},
+}
+
+// scriptExtensionRanges are the (augmented) Script_Extensions of every assigned code point, sorted.
+var scriptExtensionRanges = [...]scriptExtensionRange{
+	{0x0000, 0x0040, 0},     // Zyyy
+	{0x0041, 0x005A, 1},     // Latn
+	{0x005B, 0x0060, 0},     // Zyyy
+	{0x0061, 0x007A, 1},     // Latn
+	{0x007B, 0x00A9, 0},     // Zyyy
+	{0x00AA, 0x00AA, 1},     // Latn
+	{0x00AB, 0x00B9, 0},     // Zyyy
+	{0x00BA, 0x00BA, 1},     // Latn
+	{0x00BB, 0x00BF, 0},     // Zyyy
+	{0x00C0, 0x00D6, 1},     // Latn
+	{0x00D7, 0x00D7, 0},     // Zyyy
+	{0x00D8, 0x00F6, 1},     // Latn
+	{0x00F7, 0x00F7, 0},     // Zyyy
+	{0x00F8, 0x02B8, 1},     // Latn
+	{0x02B9, 0x02DF, 0},     // Zyyy
+	{0x02E0, 0x02E4, 1},     // Latn
+	{0x02E5, 0x02E9, 0},     // Zyyy
+	{0x02EA, 0x02EB, 2},     // Bopo Hanb
+	{0x02EC, 0x02FF, 0},     // Zyyy
+	{0x0300, 0x0341, 0},     // Zinh
+	{0x0342, 0x0342, 3},     // Grek
+	{0x0343, 0x0344, 0},     // Zinh
+	{0x0345, 0x0345, 3},     // Grek
+	{0x0346, 0x0362, 0},     // Zinh
+	{0x0363, 0x036F, 1},     // Latn
+	{0x0370, 0x0373, 3},     // Grek
+	{0x0374, 0x0374, 0},     // Zyyy
+	{0x0375, 0x0377, 3},     // Grek
+	{0x037A, 0x037D, 3},     // Grek
+	{0x037E, 0x037E, 0},     // Zyyy
+	{0x037F, 0x037F, 3},     // Grek
+	{0x0384, 0x0384, 3},     // Grek
+	{0x0385, 0x0385, 0},     // Zyyy
+	{0x0386, 0x0386, 3},     // Grek
+	{0x0387, 0x0387, 0},     // Zyyy
+	{0x0388, 0x038A, 3},     // Grek
+	{0x038C, 0x038C, 3},     // Grek
+	{0x038E, 0x03A1, 3},     // Grek
+	{0x03A3, 0x03E1, 3},     // Grek
+	{0x03E2, 0x03EF, 4},     // Copt
+	{0x03F0, 0x03FF, 3},     // Grek
+	{0x0400, 0x0482, 5},     // Cyrl
+	{0x0483, 0x0483, 6},     // Cyrl Perm
+	{0x0484, 0x0484, 7},     // Cyrl Glag
+	{0x0485, 0x0486, 8},     // Cyrl Latn
+	{0x0487, 0x0487, 7},     // Cyrl Glag
+	{0x0488, 0x052F, 5},     // Cyrl
+	{0x0531, 0x0556, 9},     // Armn
+	{0x0559, 0x058A, 9},     // Armn
+	{0x058D, 0x058F, 9},     // Armn
+	{0x0591, 0x05C7, 10},    // Hebr
+	{0x05D0, 0x05EA, 10},    // Hebr
+	{0x05EF, 0x05F4, 10},    // Hebr
+	{0x0600, 0x0604, 11},    // Arab
+	{0x0605, 0x0605, 0},     // Zyyy
+	{0x0606, 0x060B, 11},    // Arab
+	{0x060C, 0x060C, 12},    // Arab Nkoo Rohg Syrc Thaa Yezi
+	{0x060D, 0x061A, 11},    // Arab
+	{0x061B, 0x061B, 12},    // Arab Nkoo Rohg Syrc Thaa Yezi
+	{0x061C, 0x061C, 13},    // Arab Syrc Thaa
+	{0x061D, 0x061E, 11},    // Arab
+	{0x061F, 0x061F, 14},    // Adlm Arab Nkoo Rohg Syrc Thaa Yezi
+	{0x0620, 0x063F, 11},    // Arab
+	{0x0640, 0x0640, 15},    // Adlm Arab Mand Mani Ougr Phlp Rohg Sogd Syrc
+	{0x0641, 0x064A, 11},    // Arab
+	{0x064B, 0x0655, 16},    // Arab Syrc
+	{0x0656, 0x065F, 11},    // Arab
+	{0x0660, 0x0669, 17},    // Arab Thaa Yezi
+	{0x066A, 0x066F, 11},    // Arab
+	{0x0670, 0x0670, 16},    // Arab Syrc
+	{0x0671, 0x06D3, 11},    // Arab
+	{0x06D4, 0x06D4, 18},    // Arab Rohg
+	{0x06D5, 0x06DC, 11},    // Arab
+	{0x06DD, 0x06DD, 0},     // Zyyy
+	{0x06DE, 0x06FF, 11},    // Arab
+	{0x0700, 0x070D, 19},    // Syrc
+	{0x070F, 0x074A, 19},    // Syrc
+	{0x074D, 0x074F, 19},    // Syrc
+	{0x0750, 0x077F, 11},    // Arab
+	{0x0780, 0x07B1, 20},    // Thaa
+	{0x07C0, 0x07FA, 21},    // Nkoo
+	{0x07FD, 0x07FF, 21},    // Nkoo
+	{0x0800, 0x082D, 22},    // Samr
+	{0x0830, 0x083E, 22},    // Samr
+	{0x0840, 0x085B, 23},    // Mand
+	{0x085E, 0x085E, 23},    // Mand
+	{0x0860, 0x086A, 19},    // Syrc
+	{0x0870, 0x088E, 11},    // Arab
+	{0x0890, 0x0891, 11},    // Arab
+	{0x0898, 0x08E1, 11},    // Arab
+	{0x08E2, 0x08E2, 0},     // Zyyy
+	{0x08E3, 0x08FF, 11},    // Arab
+	{0x0900, 0x0950, 24},    // Deva
+	{0x0951, 0x0951, 25},    // Beng Deva Gran Gujr Guru Knda Latn Mlym Orya Shrd Taml Telu Tirh
+	{0x0952, 0x0952, 26},    // Beng Deva Gran Gujr Guru Knda Latn Mlym Orya Taml Telu Tirh
+	{0x0953, 0x0954, 0},     // Zinh
+	{0x0955, 0x0963, 24},    // Deva
+	{0x0964, 0x0964, 27},    // Beng Deva Dogr Gong Gonm Gran Gujr Guru Knda Mahj Mlym Nand Orya Sind Sinh Sylo Takr Taml Telu Tirh
+	{0x0965, 0x0965, 28},    // Beng Deva Dogr Gong Gonm Gran Gujr Guru Knda Limb Mahj Mlym Nand Orya Sind Sinh Sylo Takr Taml Telu Tirh
+	{0x0966, 0x096F, 29},    // Deva Dogr Kthi Mahj
+	{0x0970, 0x097F, 24},    // Deva
+	{0x0980, 0x0983, 30},    // Beng
+	{0x0985, 0x098C, 30},    // Beng
+	{0x098F, 0x0990, 30},    // Beng
+	{0x0993, 0x09A8, 30},    // Beng
+	{0x09AA, 0x09B0, 30},    // Beng
+	{0x09B2, 0x09B2, 30},    // Beng
+	{0x09B6, 0x09B9, 30},    // Beng
+	{0x09BC, 0x09C4, 30},    // Beng
+	{0x09C7, 0x09C8, 30},    // Beng
+	{0x09CB, 0x09CE, 30},    // Beng
+	{0x09D7, 0x09D7, 30},    // Beng
+	{0x09DC, 0x09DD, 30},    // Beng
+	{0x09DF, 0x09E3, 30},    // Beng
+	{0x09E6, 0x09EF, 31},    // Beng Cakm Sylo
+	{0x09F0, 0x09FE, 30},    // Beng
+	{0x0A01, 0x0A03, 32},    // Guru
+	{0x0A05, 0x0A0A, 32},    // Guru
+	{0x0A0F, 0x0A10, 32},    // Guru
+	{0x0A13, 0x0A28, 32},    // Guru
+	{0x0A2A, 0x0A30, 32},    // Guru
+	{0x0A32, 0x0A33, 32},    // Guru
+	{0x0A35, 0x0A36, 32},    // Guru
+	{0x0A38, 0x0A39, 32},    // Guru
+	{0x0A3C, 0x0A3C, 32},    // Guru
+	{0x0A3E, 0x0A42, 32},    // Guru
+	{0x0A47, 0x0A48, 32},    // Guru
+	{0x0A4B, 0x0A4D, 32},    // Guru
+	{0x0A51, 0x0A51, 32},    // Guru
+	{0x0A59, 0x0A5C, 32},    // Guru
+	{0x0A5E, 0x0A5E, 32},    // Guru
+	{0x0A66, 0x0A6F, 33},    // Guru Mult
+	{0x0A70, 0x0A76, 32},    // Guru
+	{0x0A81, 0x0A83, 34},    // Gujr
+	{0x0A85, 0x0A8D, 34},    // Gujr
+	{0x0A8F, 0x0A91, 34},    // Gujr
+	{0x0A93, 0x0AA8, 34},    // Gujr
+	{0x0AAA, 0x0AB0, 34},    // Gujr
+	{0x0AB2, 0x0AB3, 34},    // Gujr
+	{0x0AB5, 0x0AB9, 34},    // Gujr
+	{0x0ABC, 0x0AC5, 34},    // Gujr
+	{0x0AC7, 0x0AC9, 34},    // Gujr
+	{0x0ACB, 0x0ACD, 34},    // Gujr
+	{0x0AD0, 0x0AD0, 34},    // Gujr
+	{0x0AE0, 0x0AE3, 34},    // Gujr
+	{0x0AE6, 0x0AEF, 35},    // Gujr Khoj
+	{0x0AF0, 0x0AF1, 34},    // Gujr
+	{0x0AF9, 0x0AFF, 34},    // Gujr
+	{0x0B01, 0x0B03, 36},    // Orya
+	{0x0B05, 0x0B0C, 36},    // Orya
+	{0x0B0F, 0x0B10, 36},    // Orya
+	{0x0B13, 0x0B28, 36},    // Orya
+	{0x0B2A, 0x0B30, 36},    // Orya
+	{0x0B32, 0x0B33, 36},    // Orya
+	{0x0B35, 0x0B39, 36},    // Orya
+	{0x0B3C, 0x0B44, 36},    // Orya
+	{0x0B47, 0x0B48, 36},    // Orya
+	{0x0B4B, 0x0B4D, 36},    // Orya
+	{0x0B55, 0x0B57, 36},    // Orya
+	{0x0B5C, 0x0B5D, 36},    // Orya
+	{0x0B5F, 0x0B63, 36},    // Orya
+	{0x0B66, 0x0B77, 36},    // Orya
+	{0x0B82, 0x0B83, 37},    // Taml
+	{0x0B85, 0x0B8A, 37},    // Taml
+	{0x0B8E, 0x0B90, 37},    // Taml
+	{0x0B92, 0x0B95, 37},    // Taml
+	{0x0B99, 0x0B9A, 37},    // Taml
+	{0x0B9C, 0x0B9C, 37},    // Taml
+	{0x0B9E, 0x0B9F, 37},    // Taml
+	{0x0BA3, 0x0BA4, 37},    // Taml
+	{0x0BA8, 0x0BAA, 37},    // Taml
+	{0x0BAE, 0x0BB9, 37},    // Taml
+	{0x0BBE, 0x0BC2, 37},    // Taml
+	{0x0BC6, 0x0BC8, 37},    // Taml
+	{0x0BCA, 0x0BCD, 37},    // Taml
+	{0x0BD0, 0x0BD0, 37},    // Taml
+	{0x0BD7, 0x0BD7, 37},    // Taml
+	{0x0BE6, 0x0BF3, 38},    // Gran Taml
+	{0x0BF4, 0x0BFA, 37},    // Taml
+	{0x0C00, 0x0C0C, 39},    // Telu
+	{0x0C0E, 0x0C10, 39},    // Telu
+	{0x0C12, 0x0C28, 39},    // Telu
+	{0x0C2A, 0x0C39, 39},    // Telu
+	{0x0C3C, 0x0C44, 39},    // Telu
+	{0x0C46, 0x0C48, 39},    // Telu
+	{0x0C4A, 0x0C4D, 39},    // Telu
+	{0x0C55, 0x0C56, 39},    // Telu
+	{0x0C58, 0x0C5A, 39},    // Telu
+	{0x0C5D, 0x0C5D, 39},    // Telu
+	{0x0C60, 0x0C63, 39},    // Telu
+	{0x0C66, 0x0C6F, 39},    // Telu
+	{0x0C77, 0x0C7F, 39},    // Telu
+	{0x0C80, 0x0C8C, 40},    // Knda
+	{0x0C8E, 0x0C90, 40},    // Knda
+	{0x0C92, 0x0CA8, 40},    // Knda
+	{0x0CAA, 0x0CB3, 40},    // Knda
+	{0x0CB5, 0x0CB9, 40},    // Knda
+	{0x0CBC, 0x0CC4, 40},    // Knda
+	{0x0CC6, 0x0CC8, 40},    // Knda
+	{0x0CCA, 0x0CCD, 40},    // Knda
+	{0x0CD5, 0x0CD6, 40},    // Knda
+	{0x0CDD, 0x0CDE, 40},    // Knda
+	{0x0CE0, 0x0CE3, 40},    // Knda
+	{0x0CE6, 0x0CEF, 41},    // Knda Nand
+	{0x0CF1, 0x0CF3, 40},    // Knda
+	{0x0D00, 0x0D0C, 42},    // Mlym
+	{0x0D0E, 0x0D10, 42},    // Mlym
+	{0x0D12, 0x0D44, 42},    // Mlym
+	{0x0D46, 0x0D48, 42},    // Mlym
+	{0x0D4A, 0x0D4F, 42},    // Mlym
+	{0x0D54, 0x0D63, 42},    // Mlym
+	{0x0D66, 0x0D7F, 42},    // Mlym
+	{0x0D81, 0x0D83, 43},    // Sinh
+	{0x0D85, 0x0D96, 43},    // Sinh
+	{0x0D9A, 0x0DB1, 43},    // Sinh
+	{0x0DB3, 0x0DBB, 43},    // Sinh
+	{0x0DBD, 0x0DBD, 43},    // Sinh
+	{0x0DC0, 0x0DC6, 43},    // Sinh
+	{0x0DCA, 0x0DCA, 43},    // Sinh
+	{0x0DCF, 0x0DD4, 43},    // Sinh
+	{0x0DD6, 0x0DD6, 43},    // Sinh
+	{0x0DD8, 0x0DDF, 43},    // Sinh
+	{0x0DE6, 0x0DEF, 43},    // Sinh
+	{0x0DF2, 0x0DF4, 43},    // Sinh
+	{0x0E01, 0x0E3A, 44},    // Thai
+	{0x0E3F, 0x0E3F, 0},     // Zyyy
+	{0x0E40, 0x0E5B, 44},    // Thai
+	{0x0E81, 0x0E82, 45},    // Laoo
+	{0x0E84, 0x0E84, 45},    // Laoo
+	{0x0E86, 0x0E8A, 45},    // Laoo
+	{0x0E8C, 0x0EA3, 45},    // Laoo
+	{0x0EA5, 0x0EA5, 45},    // Laoo
+	{0x0EA7, 0x0EBD, 45},    // Laoo
+	{0x0EC0, 0x0EC4, 45},    // Laoo
+	{0x0EC6, 0x0EC6, 45},    // Laoo
+	{0x0EC8, 0x0ECE, 45},    // Laoo
+	{0x0ED0, 0x0ED9, 45},    // Laoo
+	{0x0EDC, 0x0EDF, 45},    // Laoo
+	{0x0F00, 0x0F47, 46},    // Tibt
+	{0x0F49, 0x0F6C, 46},    // Tibt
+	{0x0F71, 0x0F97, 46},    // Tibt
+	{0x0F99, 0x0FBC, 46},    // Tibt
+	{0x0FBE, 0x0FCC, 46},    // Tibt
+	{0x0FCE, 0x0FD4, 46},    // Tibt
+	{0x0FD5, 0x0FD8, 0},     // Zyyy
+	{0x0FD9, 0x0FDA, 46},    // Tibt
+	{0x1000, 0x103F, 47},    // Mymr
+	{0x1040, 0x1049, 48},    // Cakm Mymr Tale
+	{0x104A, 0x109F, 47},    // Mymr
+	{0x10A0, 0x10C5, 49},    // Geor
+	{0x10C7, 0x10C7, 49},    // Geor
+	{0x10CD, 0x10CD, 49},    // Geor
+	{0x10D0, 0x10FA, 49},    // Geor
+	{0x10FB, 0x10FB, 50},    // Geor Latn
+	{0x10FC, 0x10FF, 49},    // Geor
+	{0x1100, 0x11FF, 51},    // Hang Kore
+	{0x1200, 0x1248, 52},    // Ethi
+	{0x124A, 0x124D, 52},    // Ethi
+	{0x1250, 0x1256, 52},    // Ethi
+	{0x1258, 0x1258, 52},    // Ethi
+	{0x125A, 0x125D, 52},    // Ethi
+	{0x1260, 0x1288, 52},    // Ethi
+	{0x128A, 0x128D, 52},    // Ethi
+	{0x1290, 0x12B0, 52},    // Ethi
+	{0x12B2, 0x12B5, 52},    // Ethi
+	{0x12B8, 0x12BE, 52},    // Ethi
+	{0x12C0, 0x12C0, 52},    // Ethi
+	{0x12C2, 0x12C5, 52},    // Ethi
+	{0x12C8, 0x12D6, 52},    // Ethi
+	{0x12D8, 0x1310, 52},    // Ethi
+	{0x1312, 0x1315, 52},    // Ethi
+	{0x1318, 0x135A, 52},    // Ethi
+	{0x135D, 0x137C, 52},    // Ethi
+	{0x1380, 0x1399, 52},    // Ethi
+	{0x13A0, 0x13F5, 53},    // Cher
+	{0x13F8, 0x13FD, 53},    // Cher
+	{0x1400, 0x167F, 54},    // Cans
+	{0x1680, 0x169C, 55},    // Ogam
+	{0x16A0, 0x16EA, 56},    // Runr
+	{0x16EB, 0x16ED, 0},     // Zyyy
+	{0x16EE, 0x16F8, 56},    // Runr
+	{0x1700, 0x1715, 57},    // Tglg
+	{0x171F, 0x171F, 57},    // Tglg
+	{0x1720, 0x1734, 58},    // Hano
+	{0x1735, 0x1736, 59},    // Buhd Hano Tagb Tglg
+	{0x1740, 0x1753, 60},    // Buhd
+	{0x1760, 0x176C, 61},    // Tagb
+	{0x176E, 0x1770, 61},    // Tagb
+	{0x1772, 0x1773, 61},    // Tagb
+	{0x1780, 0x17DD, 62},    // Khmr
+	{0x17E0, 0x17E9, 62},    // Khmr
+	{0x17F0, 0x17F9, 62},    // Khmr
+	{0x1800, 0x1801, 63},    // Mong
+	{0x1802, 0x1803, 64},    // Mong Phag
+	{0x1804, 0x1804, 63},    // Mong
+	{0x1805, 0x1805, 64},    // Mong Phag
+	{0x1806, 0x1819, 63},    // Mong
+	{0x1820, 0x1878, 63},    // Mong
+	{0x1880, 0x18AA, 63},    // Mong
+	{0x18B0, 0x18F5, 54},    // Cans
+	{0x1900, 0x191E, 65},    // Limb
+	{0x1920, 0x192B, 65},    // Limb
+	{0x1930, 0x193B, 65},    // Limb
+	{0x1940, 0x1940, 65},    // Limb
+	{0x1944, 0x194F, 65},    // Limb
+	{0x1950, 0x196D, 66},    // Tale
+	{0x1970, 0x1974, 66},    // Tale
+	{0x1980, 0x19AB, 67},    // Talu
+	{0x19B0, 0x19C9, 67},    // Talu
+	{0x19D0, 0x19DA, 67},    // Talu
+	{0x19DE, 0x19DF, 67},    // Talu
+	{0x19E0, 0x19FF, 62},    // Khmr
+	{0x1A00, 0x1A1B, 68},    // Bugi
+	{0x1A1E, 0x1A1F, 68},    // Bugi
+	{0x1A20, 0x1A5E, 69},    // Lana
+	{0x1A60, 0x1A7C, 69},    // Lana
+	{0x1A7F, 0x1A89, 69},    // Lana
+	{0x1A90, 0x1A99, 69},    // Lana
+	{0x1AA0, 0x1AAD, 69},    // Lana
+	{0x1AB0, 0x1ACE, 0},     // Zinh
+	{0x1B00, 0x1B4C, 70},    // Bali
+	{0x1B50, 0x1B7E, 70},    // Bali
+	{0x1B80, 0x1BBF, 71},    // Sund
+	{0x1BC0, 0x1BF3, 72},    // Batk
+	{0x1BFC, 0x1BFF, 72},    // Batk
+	{0x1C00, 0x1C37, 73},    // Lepc
+	{0x1C3B, 0x1C49, 73},    // Lepc
+	{0x1C4D, 0x1C4F, 73},    // Lepc
+	{0x1C50, 0x1C7F, 74},    // Olck
+	{0x1C80, 0x1C88, 5},     // Cyrl
+	{0x1C90, 0x1CBA, 49},    // Geor
+	{0x1CBD, 0x1CBF, 49},    // Geor
+	{0x1CC0, 0x1CC7, 71},    // Sund
+	{0x1CD0, 0x1CD0, 75},    // Beng Deva Gran Knda
+	{0x1CD1, 0x1CD1, 24},    // Deva
+	{0x1CD2, 0x1CD2, 75},    // Beng Deva Gran Knda
+	{0x1CD3, 0x1CD3, 76},    // Deva Gran
+	{0x1CD4, 0x1CD4, 24},    // Deva
+	{0x1CD5, 0x1CD6, 77},    // Beng Deva
+	{0x1CD7, 0x1CD7, 78},    // Deva Shrd
+	{0x1CD8, 0x1CD8, 77},    // Beng Deva
+	{0x1CD9, 0x1CD9, 78},    // Deva Shrd
+	{0x1CDA, 0x1CDA, 79},    // Deva Knda Mlym Orya Taml Telu
+	{0x1CDB, 0x1CDB, 24},    // Deva
+	{0x1CDC, 0x1CDD, 78},    // Deva Shrd
+	{0x1CDE, 0x1CDF, 24},    // Deva
+	{0x1CE0, 0x1CE0, 78},    // Deva Shrd
+	{0x1CE1, 0x1CE1, 77},    // Beng Deva
+	{0x1CE2, 0x1CE8, 24},    // Deva
+	{0x1CE9, 0x1CE9, 80},    // Deva Nand
+	{0x1CEA, 0x1CEA, 77},    // Beng Deva
+	{0x1CEB, 0x1CEC, 24},    // Deva
+	{0x1CED, 0x1CED, 77},    // Beng Deva
+	{0x1CEE, 0x1CF1, 24},    // Deva
+	{0x1CF2, 0x1CF2, 81},    // Beng Deva Gran Knda Nand Orya Telu Tirh
+	{0x1CF3, 0x1CF3, 76},    // Deva Gran
+	{0x1CF4, 0x1CF4, 82},    // Deva Gran Knda
+	{0x1CF5, 0x1CF6, 77},    // Beng Deva
+	{0x1CF7, 0x1CF7, 30},    // Beng
+	{0x1CF8, 0x1CF9, 76},    // Deva Gran
+	{0x1CFA, 0x1CFA, 83},    // Nand
+	{0x1D00, 0x1D25, 1},     // Latn
+	{0x1D26, 0x1D2A, 3},     // Grek
+	{0x1D2B, 0x1D2B, 5},     // Cyrl
+	{0x1D2C, 0x1D5C, 1},     // Latn
+	{0x1D5D, 0x1D61, 3},     // Grek
+	{0x1D62, 0x1D65, 1},     // Latn
+	{0x1D66, 0x1D6A, 3},     // Grek
+	{0x1D6B, 0x1D77, 1},     // Latn
+	{0x1D78, 0x1D78, 5},     // Cyrl
+	{0x1D79, 0x1DBE, 1},     // Latn
+	{0x1DBF, 0x1DC1, 3},     // Grek
+	{0x1DC2, 0x1DF7, 0},     // Zinh
+	{0x1DF8, 0x1DF8, 84},    // Cyrl Syrc
+	{0x1DF9, 0x1DF9, 0},     // Zinh
+	{0x1DFA, 0x1DFA, 19},    // Syrc
+	{0x1DFB, 0x1DFF, 0},     // Zinh
+	{0x1E00, 0x1EFF, 1},     // Latn
+	{0x1F00, 0x1F15, 3},     // Grek
+	{0x1F18, 0x1F1D, 3},     // Grek
+	{0x1F20, 0x1F45, 3},     // Grek
+	{0x1F48, 0x1F4D, 3},     // Grek
+	{0x1F50, 0x1F57, 3},     // Grek
+	{0x1F59, 0x1F59, 3},     // Grek
+	{0x1F5B, 0x1F5B, 3},     // Grek
+	{0x1F5D, 0x1F5D, 3},     // Grek
+	{0x1F5F, 0x1F7D, 3},     // Grek
+	{0x1F80, 0x1FB4, 3},     // Grek
+	{0x1FB6, 0x1FC4, 3},     // Grek
+	{0x1FC6, 0x1FD3, 3},     // Grek
+	{0x1FD6, 0x1FDB, 3},     // Grek
+	{0x1FDD, 0x1FEF, 3},     // Grek
+	{0x1FF2, 0x1FF4, 3},     // Grek
+	{0x1FF6, 0x1FFE, 3},     // Grek
+	{0x2000, 0x200B, 0},     // Zyyy
+	{0x200C, 0x200D, 0},     // Zinh
+	{0x200E, 0x202E, 0},     // Zyyy
+	{0x202F, 0x202F, 85},    // Latn Mong
+	{0x2030, 0x2064, 0},     // Zyyy
+	{0x2066, 0x2070, 0},     // Zyyy
+	{0x2071, 0x2071, 1},     // Latn
+	{0x2074, 0x207E, 0},     // Zyyy
+	{0x207F, 0x207F, 1},     // Latn
+	{0x2080, 0x208E, 0},     // Zyyy
+	{0x2090, 0x209C, 1},     // Latn
+	{0x20A0, 0x20C0, 0},     // Zyyy
+	{0x20D0, 0x20EF, 0},     // Zinh
+	{0x20F0, 0x20F0, 86},    // Deva Gran Latn
+	{0x2100, 0x2125, 0},     // Zyyy
+	{0x2126, 0x2126, 3},     // Grek
+	{0x2127, 0x2129, 0},     // Zyyy
+	{0x212A, 0x212B, 1},     // Latn
+	{0x212C, 0x2131, 0},     // Zyyy
+	{0x2132, 0x2132, 1},     // Latn
+	{0x2133, 0x214D, 0},     // Zyyy
+	{0x214E, 0x214E, 1},     // Latn
+	{0x214F, 0x215F, 0},     // Zyyy
+	{0x2160, 0x2188, 1},     // Latn
+	{0x2189, 0x218B, 0},     // Zyyy
+	{0x2190, 0x2426, 0},     // Zyyy
+	{0x2440, 0x244A, 0},     // Zyyy
+	{0x2460, 0x27FF, 0},     // Zyyy
+	{0x2800, 0x28FF, 87},    // Brai
+	{0x2900, 0x2B73, 0},     // Zyyy
+	{0x2B76, 0x2B95, 0},     // Zyyy
+	{0x2B97, 0x2BFF, 0},     // Zyyy
+	{0x2C00, 0x2C5F, 88},    // Glag
+	{0x2C60, 0x2C7F, 1},     // Latn
+	{0x2C80, 0x2CF3, 4},     // Copt
+	{0x2CF9, 0x2CFF, 4},     // Copt
+	{0x2D00, 0x2D25, 49},    // Geor
+	{0x2D27, 0x2D27, 49},    // Geor
+	{0x2D2D, 0x2D2D, 49},    // Geor
+	{0x2D30, 0x2D67, 89},    // Tfng
+	{0x2D6F, 0x2D70, 89},    // Tfng
+	{0x2D7F, 0x2D7F, 89},    // Tfng
+	{0x2D80, 0x2D96, 52},    // Ethi
+	{0x2DA0, 0x2DA6, 52},    // Ethi
+	{0x2DA8, 0x2DAE, 52},    // Ethi
+	{0x2DB0, 0x2DB6, 52},    // Ethi
+	{0x2DB8, 0x2DBE, 52},    // Ethi
+	{0x2DC0, 0x2DC6, 52},    // Ethi
+	{0x2DC8, 0x2DCE, 52},    // Ethi
+	{0x2DD0, 0x2DD6, 52},    // Ethi
+	{0x2DD8, 0x2DDE, 52},    // Ethi
+	{0x2DE0, 0x2DFF, 5},     // Cyrl
+	{0x2E00, 0x2E42, 0},     // Zyyy
+	{0x2E43, 0x2E43, 7},     // Cyrl Glag
+	{0x2E44, 0x2E5D, 0},     // Zyyy
+	{0x2E80, 0x2E99, 90},    // Hani Hanb Jpan Kore
+	{0x2E9B, 0x2EF3, 90},    // Hani Hanb Jpan Kore
+	{0x2F00, 0x2FD5, 90},    // Hani Hanb Jpan Kore
+	{0x2FF0, 0x2FFB, 0},     // Zyyy
+	{0x3000, 0x3000, 0},     // Zyyy
+	{0x3001, 0x3002, 91},    // Bopo Hang Hani Hira Kana Yiii Hanb Kore Jpan
+	{0x3003, 0x3003, 92},    // Bopo Hang Hani Hira Kana Hanb Kore Jpan
+	{0x3004, 0x3004, 0},     // Zyyy
+	{0x3005, 0x3007, 90},    // Hani Hanb Jpan Kore
+	{0x3008, 0x3011, 91},    // Bopo Hang Hani Hira Kana Yiii Hanb Kore Jpan
+	{0x3012, 0x3012, 0},     // Zyyy
+	{0x3013, 0x3013, 92},    // Bopo Hang Hani Hira Kana Hanb Kore Jpan
+	{0x3014, 0x301B, 91},    // Bopo Hang Hani Hira Kana Yiii Hanb Kore Jpan
+	{0x301C, 0x301F, 92},    // Bopo Hang Hani Hira Kana Hanb Kore Jpan
+	{0x3020, 0x3020, 0},     // Zyyy
+	{0x3021, 0x3029, 90},    // Hani Hanb Jpan Kore
+	{0x302A, 0x302D, 93},    // Bopo Hani Hanb Jpan Kore
+	{0x302E, 0x302F, 51},    // Hang Kore
+	{0x3030, 0x3030, 92},    // Bopo Hang Hani Hira Kana Hanb Kore Jpan
+	{0x3031, 0x3035, 94},    // Hira Kana Jpan
+	{0x3036, 0x3036, 0},     // Zyyy
+	{0x3037, 0x3037, 92},    // Bopo Hang Hani Hira Kana Hanb Kore Jpan
+	{0x3038, 0x303B, 90},    // Hani Hanb Jpan Kore
+	{0x303C, 0x303D, 95},    // Hani Hira Kana Hanb Jpan Kore
+	{0x303E, 0x303F, 90},    // Hani Hanb Jpan Kore
+	{0x3041, 0x3096, 96},    // Hira Jpan
+	{0x3099, 0x309C, 94},    // Hira Kana Jpan
+	{0x309D, 0x309F, 96},    // Hira Jpan
+	{0x30A0, 0x30A0, 94},    // Hira Kana Jpan
+	{0x30A1, 0x30FA, 97},    // Kana Jpan
+	{0x30FB, 0x30FB, 91},    // Bopo Hang Hani Hira Kana Yiii Hanb Kore Jpan
+	{0x30FC, 0x30FC, 94},    // Hira Kana Jpan
+	{0x30FD, 0x30FF, 97},    // Kana Jpan
+	{0x3105, 0x312F, 2},     // Bopo Hanb
+	{0x3131, 0x318E, 51},    // Hang Kore
+	{0x3190, 0x319F, 90},    // Hani Hanb Jpan Kore
+	{0x31A0, 0x31BF, 2},     // Bopo Hanb
+	{0x31C0, 0x31E3, 90},    // Hani Hanb Jpan Kore
+	{0x31F0, 0x31FF, 97},    // Kana Jpan
+	{0x3200, 0x321E, 51},    // Hang Kore
+	{0x3220, 0x3247, 90},    // Hani Hanb Jpan Kore
+	{0x3248, 0x325F, 0},     // Zyyy
+	{0x3260, 0x327E, 51},    // Hang Kore
+	{0x327F, 0x327F, 0},     // Zyyy
+	{0x3280, 0x32B0, 90},    // Hani Hanb Jpan Kore
+	{0x32B1, 0x32BF, 0},     // Zyyy
+	{0x32C0, 0x32CB, 90},    // Hani Hanb Jpan Kore
+	{0x32CC, 0x32CF, 0},     // Zyyy
+	{0x32D0, 0x32FE, 97},    // Kana Jpan
+	{0x32FF, 0x32FF, 90},    // Hani Hanb Jpan Kore
+	{0x3300, 0x3357, 97},    // Kana Jpan
+	{0x3358, 0x3370, 90},    // Hani Hanb Jpan Kore
+	{0x3371, 0x337A, 0},     // Zyyy
+	{0x337B, 0x337F, 90},    // Hani Hanb Jpan Kore
+	{0x3380, 0x33DF, 0},     // Zyyy
+	{0x33E0, 0x33FE, 90},    // Hani Hanb Jpan Kore
+	{0x33FF, 0x33FF, 0},     // Zyyy
+	{0x3400, 0x4DBF, 90},    // Hani Hanb Jpan Kore
+	{0x4DC0, 0x4DFF, 0},     // Zyyy
+	{0x4E00, 0x9FFF, 90},    // Hani Hanb Jpan Kore
+	{0xA000, 0xA48C, 98},    // Yiii
+	{0xA490, 0xA4C6, 98},    // Yiii
+	{0xA4D0, 0xA4FF, 99},    // Lisu
+	{0xA500, 0xA62B, 100},   // Vaii
+	{0xA640, 0xA66E, 5},     // Cyrl
+	{0xA66F, 0xA66F, 7},     // Cyrl Glag
+	{0xA670, 0xA69F, 5},     // Cyrl
+	{0xA6A0, 0xA6F7, 101},   // Bamu
+	{0xA700, 0xA707, 102},   // Hani Latn Hanb Jpan Kore
+	{0xA708, 0xA721, 0},     // Zyyy
+	{0xA722, 0xA787, 1},     // Latn
+	{0xA788, 0xA78A, 0},     // Zyyy
+	{0xA78B, 0xA7CA, 1},     // Latn
+	{0xA7D0, 0xA7D1, 1},     // Latn
+	{0xA7D3, 0xA7D3, 1},     // Latn
+	{0xA7D5, 0xA7D9, 1},     // Latn
+	{0xA7F2, 0xA7FF, 1},     // Latn
+	{0xA800, 0xA82C, 103},   // Sylo
+	{0xA830, 0xA832, 104},   // Deva Dogr Gujr Guru Khoj Knda Kthi Mahj Mlym Modi Nand Sind Takr Tirh
+	{0xA833, 0xA835, 105},   // Deva Dogr Gujr Guru Khoj Knda Kthi Mahj Modi Nand Sind Takr Tirh
+	{0xA836, 0xA839, 106},   // Deva Dogr Gujr Guru Khoj Kthi Mahj Modi Sind Takr Tirh
+	{0xA840, 0xA877, 107},   // Phag
+	{0xA880, 0xA8C5, 108},   // Saur
+	{0xA8CE, 0xA8D9, 108},   // Saur
+	{0xA8E0, 0xA8F0, 24},    // Deva
+	{0xA8F1, 0xA8F1, 77},    // Beng Deva
+	{0xA8F2, 0xA8F2, 24},    // Deva
+	{0xA8F3, 0xA8F3, 109},   // Deva Taml
+	{0xA8F4, 0xA8FF, 24},    // Deva
+	{0xA900, 0xA92D, 110},   // Kali
+	{0xA92E, 0xA92E, 111},   // Kali Latn Mymr
+	{0xA92F, 0xA92F, 110},   // Kali
+	{0xA930, 0xA953, 112},   // Rjng
+	{0xA95F, 0xA95F, 112},   // Rjng
+	{0xA960, 0xA97C, 51},    // Hang Kore
+	{0xA980, 0xA9CD, 113},   // Java
+	{0xA9CF, 0xA9CF, 114},   // Bugi Java
+	{0xA9D0, 0xA9D9, 113},   // Java
+	{0xA9DE, 0xA9DF, 113},   // Java
+	{0xA9E0, 0xA9FE, 47},    // Mymr
+	{0xAA00, 0xAA36, 115},   // Cham
+	{0xAA40, 0xAA4D, 115},   // Cham
+	{0xAA50, 0xAA59, 115},   // Cham
+	{0xAA5C, 0xAA5F, 115},   // Cham
+	{0xAA60, 0xAA7F, 47},    // Mymr
+	{0xAA80, 0xAAC2, 116},   // Tavt
+	{0xAADB, 0xAADF, 116},   // Tavt
+	{0xAAE0, 0xAAF6, 117},   // Mtei
+	{0xAB01, 0xAB06, 52},    // Ethi
+	{0xAB09, 0xAB0E, 52},    // Ethi
+	{0xAB11, 0xAB16, 52},    // Ethi
+	{0xAB20, 0xAB26, 52},    // Ethi
+	{0xAB28, 0xAB2E, 52},    // Ethi
+	{0xAB30, 0xAB5A, 1},     // Latn
+	{0xAB5B, 0xAB5B, 0},     // Zyyy
+	{0xAB5C, 0xAB64, 1},     // Latn
+	{0xAB65, 0xAB65, 3},     // Grek
+	{0xAB66, 0xAB69, 1},     // Latn
+	{0xAB6A, 0xAB6B, 0},     // Zyyy
+	{0xAB70, 0xABBF, 53},    // Cher
+	{0xABC0, 0xABED, 117},   // Mtei
+	{0xABF0, 0xABF9, 117},   // Mtei
+	{0xAC00, 0xD7A3, 51},    // Hang Kore
+	{0xD7B0, 0xD7C6, 51},    // Hang Kore
+	{0xD7CB, 0xD7FB, 51},    // Hang Kore
+	{0xF900, 0xFA6D, 90},    // Hani Hanb Jpan Kore
+	{0xFA70, 0xFAD9, 90},    // Hani Hanb Jpan Kore
+	{0xFB00, 0xFB06, 1},     // Latn
+	{0xFB13, 0xFB17, 9},     // Armn
+	{0xFB1D, 0xFB36, 10},    // Hebr
+	{0xFB38, 0xFB3C, 10},    // Hebr
+	{0xFB3E, 0xFB3E, 10},    // Hebr
+	{0xFB40, 0xFB41, 10},    // Hebr
+	{0xFB43, 0xFB44, 10},    // Hebr
+	{0xFB46, 0xFB4F, 10},    // Hebr
+	{0xFB50, 0xFBC2, 11},    // Arab
+	{0xFBD3, 0xFD3D, 11},    // Arab
+	{0xFD3E, 0xFD3F, 118},   // Arab Nkoo
+	{0xFD40, 0xFD8F, 11},    // Arab
+	{0xFD92, 0xFDC7, 11},    // Arab
+	{0xFDCF, 0xFDCF, 11},    // Arab
+	{0xFDF0, 0xFDF1, 11},    // Arab
+	{0xFDF2, 0xFDF2, 119},   // Arab Thaa
+	{0xFDF3, 0xFDFC, 11},    // Arab
+	{0xFDFD, 0xFDFD, 119},   // Arab Thaa
+	{0xFDFE, 0xFDFF, 11},    // Arab
+	{0xFE00, 0xFE0F, 0},     // Zinh
+	{0xFE10, 0xFE19, 0},     // Zyyy
+	{0xFE20, 0xFE2D, 0},     // Zinh
+	{0xFE2E, 0xFE2F, 5},     // Cyrl
+	{0xFE30, 0xFE44, 0},     // Zyyy
+	{0xFE45, 0xFE46, 92},    // Bopo Hang Hani Hira Kana Hanb Kore Jpan
+	{0xFE47, 0xFE52, 0},     // Zyyy
+	{0xFE54, 0xFE66, 0},     // Zyyy
+	{0xFE68, 0xFE6B, 0},     // Zyyy
+	{0xFE70, 0xFE74, 11},    // Arab
+	{0xFE76, 0xFEFC, 11},    // Arab
+	{0xFEFF, 0xFEFF, 0},     // Zyyy
+	{0xFF01, 0xFF20, 0},     // Zyyy
+	{0xFF21, 0xFF3A, 1},     // Latn
+	{0xFF3B, 0xFF40, 0},     // Zyyy
+	{0xFF41, 0xFF5A, 1},     // Latn
+	{0xFF5B, 0xFF60, 0},     // Zyyy
+	{0xFF61, 0xFF65, 91},    // Bopo Hang Hani Hira Kana Yiii Hanb Kore Jpan
+	{0xFF66, 0xFF6F, 97},    // Kana Jpan
+	{0xFF70, 0xFF70, 94},    // Hira Kana Jpan
+	{0xFF71, 0xFF9D, 97},    // Kana Jpan
+	{0xFF9E, 0xFF9F, 94},    // Hira Kana Jpan
+	{0xFFA0, 0xFFBE, 51},    // Hang Kore
+	{0xFFC2, 0xFFC7, 51},    // Hang Kore
+	{0xFFCA, 0xFFCF, 51},    // Hang Kore
+	{0xFFD2, 0xFFD7, 51},    // Hang Kore
+	{0xFFDA, 0xFFDC, 51},    // Hang Kore
+	{0xFFE0, 0xFFE6, 0},     // Zyyy
+	{0xFFE8, 0xFFEE, 0},     // Zyyy
+	{0xFFF9, 0xFFFD, 0},     // Zyyy
+	{0x10000, 0x1000B, 120}, // Linb
+	{0x1000D, 0x10026, 120}, // Linb
+	{0x10028, 0x1003A, 120}, // Linb
+	{0x1003C, 0x1003D, 120}, // Linb
+	{0x1003F, 0x1004D, 120}, // Linb
+	{0x10050, 0x1005D, 120}, // Linb
+	{0x10080, 0x100FA, 120}, // Linb
+	{0x10100, 0x10101, 121}, // Cpmn Cprt Linb
+	{0x10102, 0x10102, 122}, // Cprt Linb
+	{0x10107, 0x10133, 123}, // Cprt Lina Linb
+	{0x10137, 0x1013F, 122}, // Cprt Linb
+	{0x10140, 0x1018E, 3},   // Grek
+	{0x10190, 0x1019C, 0},   // Zyyy
+	{0x101A0, 0x101A0, 3},   // Grek
+	{0x101D0, 0x101FC, 0},   // Zyyy
+	{0x101FD, 0x101FD, 0},   // Zinh
+	{0x10280, 0x1029C, 124}, // Lyci
+	{0x102A0, 0x102D0, 125}, // Cari
+	{0x102E0, 0x102FB, 126}, // Arab Copt
+	{0x10300, 0x10323, 127}, // Ital
+	{0x1032D, 0x1032F, 127}, // Ital
+	{0x10330, 0x1034A, 128}, // Goth
+	{0x10350, 0x1037A, 129}, // Perm
+	{0x10380, 0x1039D, 130}, // Ugar
+	{0x1039F, 0x1039F, 130}, // Ugar
+	{0x103A0, 0x103C3, 131}, // Xpeo
+	{0x103C8, 0x103D5, 131}, // Xpeo
+	{0x10400, 0x1044F, 132}, // Dsrt
+	{0x10450, 0x1047F, 133}, // Shaw
+	{0x10480, 0x1049D, 134}, // Osma
+	{0x104A0, 0x104A9, 134}, // Osma
+	{0x104B0, 0x104D3, 135}, // Osge
+	{0x104D8, 0x104FB, 135}, // Osge
+	{0x10500, 0x10527, 136}, // Elba
+	{0x10530, 0x10563, 137}, // Aghb
+	{0x1056F, 0x1056F, 137}, // Aghb
+	{0x10570, 0x1057A, 138}, // Vith
+	{0x1057C, 0x1058A, 138}, // Vith
+	{0x1058C, 0x10592, 138}, // Vith
+	{0x10594, 0x10595, 138}, // Vith
+	{0x10597, 0x105A1, 138}, // Vith
+	{0x105A3, 0x105B1, 138}, // Vith
+	{0x105B3, 0x105B9, 138}, // Vith
+	{0x105BB, 0x105BC, 138}, // Vith
+	{0x10600, 0x10736, 139}, // Lina
+	{0x10740, 0x10755, 139}, // Lina
+	{0x10760, 0x10767, 139}, // Lina
+	{0x10780, 0x10785, 1},   // Latn
+	{0x10787, 0x107B0, 1},   // Latn
+	{0x107B2, 0x107BA, 1},   // Latn
+	{0x10800, 0x10805, 140}, // Cprt
+	{0x10808, 0x10808, 140}, // Cprt
+	{0x1080A, 0x10835, 140}, // Cprt
+	{0x10837, 0x10838, 140}, // Cprt
+	{0x1083C, 0x1083C, 140}, // Cprt
+	{0x1083F, 0x1083F, 140}, // Cprt
+	{0x10840, 0x10855, 141}, // Armi
+	{0x10857, 0x1085F, 141}, // Armi
+	{0x10860, 0x1087F, 142}, // Palm
+	{0x10880, 0x1089E, 143}, // Nbat
+	{0x108A7, 0x108AF, 143}, // Nbat
+	{0x108E0, 0x108F2, 144}, // Hatr
+	{0x108F4, 0x108F5, 144}, // Hatr
+	{0x108FB, 0x108FF, 144}, // Hatr
+	{0x10900, 0x1091B, 145}, // Phnx
+	{0x1091F, 0x1091F, 145}, // Phnx
+	{0x10920, 0x10939, 146}, // Lydi
+	{0x1093F, 0x1093F, 146}, // Lydi
+	{0x10980, 0x1099F, 147}, // Mero
+	{0x109A0, 0x109B7, 148}, // Merc
+	{0x109BC, 0x109CF, 148}, // Merc
+	{0x109D2, 0x109FF, 148}, // Merc
+	{0x10A00, 0x10A03, 149}, // Khar
+	{0x10A05, 0x10A06, 149}, // Khar
+	{0x10A0C, 0x10A13, 149}, // Khar
+	{0x10A15, 0x10A17, 149}, // Khar
+	{0x10A19, 0x10A35, 149}, // Khar
+	{0x10A38, 0x10A3A, 149}, // Khar
+	{0x10A3F, 0x10A48, 149}, // Khar
+	{0x10A50, 0x10A58, 149}, // Khar
+	{0x10A60, 0x10A7F, 150}, // Sarb
+	{0x10A80, 0x10A9F, 151}, // Narb
+	{0x10AC0, 0x10AE6, 152}, // Mani
+	{0x10AEB, 0x10AF1, 152}, // Mani
+	{0x10AF2, 0x10AF2, 153}, // Mani Ougr
+	{0x10AF3, 0x10AF6, 152}, // Mani
+	{0x10B00, 0x10B35, 154}, // Avst
+	{0x10B39, 0x10B3F, 154}, // Avst
+	{0x10B40, 0x10B55, 155}, // Prti
+	{0x10B58, 0x10B5F, 155}, // Prti
+	{0x10B60, 0x10B72, 156}, // Phli
+	{0x10B78, 0x10B7F, 156}, // Phli
+	{0x10B80, 0x10B91, 157}, // Phlp
+	{0x10B99, 0x10B9C, 157}, // Phlp
+	{0x10BA9, 0x10BAF, 157}, // Phlp
+	{0x10C00, 0x10C48, 158}, // Orkh
+	{0x10C80, 0x10CB2, 159}, // Hung
+	{0x10CC0, 0x10CF2, 159}, // Hung
+	{0x10CFA, 0x10CFF, 159}, // Hung
+	{0x10D00, 0x10D27, 160}, // Rohg
+	{0x10D30, 0x10D39, 160}, // Rohg
+	{0x10E60, 0x10E7E, 11},  // Arab
+	{0x10E80, 0x10EA9, 161}, // Yezi
+	{0x10EAB, 0x10EAD, 161}, // Yezi
+	{0x10EB0, 0x10EB1, 161}, // Yezi
+	{0x10EFD, 0x10EFF, 11},  // Arab
+	{0x10F00, 0x10F27, 162}, // Sogo
+	{0x10F30, 0x10F59, 163}, // Sogd
+	{0x10F70, 0x10F89, 164}, // Ougr
+	{0x10FB0, 0x10FCB, 165}, // Chrs
+	{0x10FE0, 0x10FF6, 166}, // Elym
+	{0x11000, 0x1104D, 167}, // Brah
+	{0x11052, 0x11075, 167}, // Brah
+	{0x1107F, 0x1107F, 167}, // Brah
+	{0x11080, 0x110C2, 168}, // Kthi
+	{0x110CD, 0x110CD, 168}, // Kthi
+	{0x110D0, 0x110E8, 169}, // Sora
+	{0x110F0, 0x110F9, 169}, // Sora
+	{0x11100, 0x11134, 170}, // Cakm
+	{0x11136, 0x11147, 170}, // Cakm
+	{0x11150, 0x11176, 171}, // Mahj
+	{0x11180, 0x111DF, 172}, // Shrd
+	{0x111E1, 0x111F4, 43},  // Sinh
+	{0x11200, 0x11211, 173}, // Khoj
+	{0x11213, 0x11241, 173}, // Khoj
+	{0x11280, 0x11286, 174}, // Mult
+	{0x11288, 0x11288, 174}, // Mult
+	{0x1128A, 0x1128D, 174}, // Mult
+	{0x1128F, 0x1129D, 174}, // Mult
+	{0x1129F, 0x112A9, 174}, // Mult
+	{0x112B0, 0x112EA, 175}, // Sind
+	{0x112F0, 0x112F9, 175}, // Sind
+	{0x11300, 0x11300, 176}, // Gran
+	{0x11301, 0x11301, 38},  // Gran Taml
+	{0x11302, 0x11302, 176}, // Gran
+	{0x11303, 0x11303, 38},  // Gran Taml
+	{0x11305, 0x1130C, 176}, // Gran
+	{0x1130F, 0x11310, 176}, // Gran
+	{0x11313, 0x11328, 176}, // Gran
+	{0x1132A, 0x11330, 176}, // Gran
+	{0x11332, 0x11333, 176}, // Gran
+	{0x11335, 0x11339, 176}, // Gran
+	{0x1133B, 0x1133C, 38},  // Gran Taml
+	{0x1133D, 0x11344, 176}, // Gran
+	{0x11347, 0x11348, 176}, // Gran
+	{0x1134B, 0x1134D, 176}, // Gran
+	{0x11350, 0x11350, 176}, // Gran
+	{0x11357, 0x11357, 176}, // Gran
+	{0x1135D, 0x11363, 176}, // Gran
+	{0x11366, 0x1136C, 176}, // Gran
+	{0x11370, 0x11374, 176}, // Gran
+	{0x11400, 0x1145B, 177}, // Newa
+	{0x1145D, 0x11461, 177}, // Newa
+	{0x11480, 0x114C7, 178}, // Tirh
+	{0x114D0, 0x114D9, 178}, // Tirh
+	{0x11580, 0x115B5, 179}, // Sidd
+	{0x115B8, 0x115DD, 179}, // Sidd
+	{0x11600, 0x11644, 180}, // Modi
+	{0x11650, 0x11659, 180}, // Modi
+	{0x11660, 0x1166C, 63},  // Mong
+	{0x11680, 0x116B9, 181}, // Takr
+	{0x116C0, 0x116C9, 181}, // Takr
+	{0x11700, 0x1171A, 182}, // Ahom
+	{0x1171D, 0x1172B, 182}, // Ahom
+	{0x11730, 0x11746, 182}, // Ahom
+	{0x11800, 0x1183B, 183}, // Dogr
+	{0x118A0, 0x118F2, 184}, // Wara
+	{0x118FF, 0x118FF, 184}, // Wara
+	{0x11900, 0x11906, 185}, // Diak
+	{0x11909, 0x11909, 185}, // Diak
+	{0x1190C, 0x11913, 185}, // Diak
+	{0x11915, 0x11916, 185}, // Diak
+	{0x11918, 0x11935, 185}, // Diak
+	{0x11937, 0x11938, 185}, // Diak
+	{0x1193B, 0x11946, 185}, // Diak
+	{0x11950, 0x11959, 185}, // Diak
+	{0x119A0, 0x119A7, 83},  // Nand
+	{0x119AA, 0x119D7, 83},  // Nand
+	{0x119DA, 0x119E4, 83},  // Nand
+	{0x11A00, 0x11A47, 186}, // Zanb
+	{0x11A50, 0x11AA2, 187}, // Soyo
+	{0x11AB0, 0x11ABF, 54},  // Cans
+	{0x11AC0, 0x11AF8, 188}, // Pauc
+	{0x11B00, 0x11B09, 24},  // Deva
+	{0x11C00, 0x11C08, 189}, // Bhks
+	{0x11C0A, 0x11C36, 189}, // Bhks
+	{0x11C38, 0x11C45, 189}, // Bhks
+	{0x11C50, 0x11C6C, 189}, // Bhks
+	{0x11C70, 0x11C8F, 190}, // Marc
+	{0x11C92, 0x11CA7, 190}, // Marc
+	{0x11CA9, 0x11CB6, 190}, // Marc
+	{0x11D00, 0x11D06, 191}, // Gonm
+	{0x11D08, 0x11D09, 191}, // Gonm
+	{0x11D0B, 0x11D36, 191}, // Gonm
+	{0x11D3A, 0x11D3A, 191}, // Gonm
+	{0x11D3C, 0x11D3D, 191}, // Gonm
+	{0x11D3F, 0x11D47, 191}, // Gonm
+	{0x11D50, 0x11D59, 191}, // Gonm
+	{0x11D60, 0x11D65, 192}, // Gong
+	{0x11D67, 0x11D68, 192}, // Gong
+	{0x11D6A, 0x11D8E, 192}, // Gong
+	{0x11D90, 0x11D91, 192}, // Gong
+	{0x11D93, 0x11D98, 192}, // Gong
+	{0x11DA0, 0x11DA9, 192}, // Gong
+	{0x11EE0, 0x11EF8, 193}, // Maka
+	{0x11F00, 0x11F10, 194}, // Kawi
+	{0x11F12, 0x11F3A, 194}, // Kawi
+	{0x11F3E, 0x11F59, 194}, // Kawi
+	{0x11FB0, 0x11FB0, 99},  // Lisu
+	{0x11FC0, 0x11FCF, 37},  // Taml
+	{0x11FD0, 0x11FD1, 38},  // Gran Taml
+	{0x11FD2, 0x11FD2, 37},  // Taml
+	{0x11FD3, 0x11FD3, 38},  // Gran Taml
+	{0x11FD4, 0x11FF1, 37},  // Taml
+	{0x11FFF, 0x11FFF, 37},  // Taml
+	{0x12000, 0x12399, 195}, // Xsux
+	{0x12400, 0x1246E, 195}, // Xsux
+	{0x12470, 0x12474, 195}, // Xsux
+	{0x12480, 0x12543, 195}, // Xsux
+	{0x12F90, 0x12FF2, 196}, // Cpmn
+	{0x13000, 0x13455, 197}, // Egyp
+	{0x14400, 0x14646, 198}, // Hluw
+	{0x16800, 0x16A38, 101}, // Bamu
+	{0x16A40, 0x16A5E, 199}, // Mroo
+	{0x16A60, 0x16A69, 199}, // Mroo
+	{0x16A6E, 0x16A6F, 199}, // Mroo
+	{0x16A70, 0x16ABE, 200}, // Tnsa
+	{0x16AC0, 0x16AC9, 200}, // Tnsa
+	{0x16AD0, 0x16AED, 201}, // Bass
+	{0x16AF0, 0x16AF5, 201}, // Bass
+	{0x16B00, 0x16B45, 202}, // Hmng
+	{0x16B50, 0x16B59, 202}, // Hmng
+	{0x16B5B, 0x16B61, 202}, // Hmng
+	{0x16B63, 0x16B77, 202}, // Hmng
+	{0x16B7D, 0x16B8F, 202}, // Hmng
+	{0x16E40, 0x16E9A, 203}, // Medf
+	{0x16F00, 0x16F4A, 204}, // Plrd
+	{0x16F4F, 0x16F87, 204}, // Plrd
+	{0x16F8F, 0x16F9F, 204}, // Plrd
+	{0x16FE0, 0x16FE0, 205}, // Tang
+	{0x16FE1, 0x16FE1, 206}, // Nshu
+	{0x16FE2, 0x16FE3, 90},  // Hani Hanb Jpan Kore
+	{0x16FE4, 0x16FE4, 207}, // Kits
+	{0x16FF0, 0x16FF1, 90},  // Hani Hanb Jpan Kore
+	{0x17000, 0x187F7, 205}, // Tang
+	{0x18800, 0x18AFF, 205}, // Tang
+	{0x18B00, 0x18CD5, 207}, // Kits
+	{0x18D00, 0x18D08, 205}, // Tang
+	{0x1AFF0, 0x1AFF3, 97},  // Kana Jpan
+	{0x1AFF5, 0x1AFFB, 97},  // Kana Jpan
+	{0x1AFFD, 0x1AFFE, 97},  // Kana Jpan
+	{0x1B000, 0x1B000, 97},  // Kana Jpan
+	{0x1B001, 0x1B11F, 96},  // Hira Jpan
+	{0x1B120, 0x1B122, 97},  // Kana Jpan
+	{0x1B132, 0x1B132, 96},  // Hira Jpan
+	{0x1B150, 0x1B152, 96},  // Hira Jpan
+	{0x1B155, 0x1B155, 97},  // Kana Jpan
+	{0x1B164, 0x1B167, 97},  // Kana Jpan
+	{0x1B170, 0x1B2FB, 206}, // Nshu
+	{0x1BC00, 0x1BC6A, 208}, // Dupl
+	{0x1BC70, 0x1BC7C, 208}, // Dupl
+	{0x1BC80, 0x1BC88, 208}, // Dupl
+	{0x1BC90, 0x1BC99, 208}, // Dupl
+	{0x1BC9C, 0x1BCA3, 208}, // Dupl
+	{0x1CF00, 0x1CF2D, 0},   // Zinh
+	{0x1CF30, 0x1CF46, 0},   // Zinh
+	{0x1CF50, 0x1CFC3, 0},   // Zyyy
+	{0x1D000, 0x1D0F5, 0},   // Zyyy
+	{0x1D100, 0x1D126, 0},   // Zyyy
+	{0x1D129, 0x1D166, 0},   // Zyyy
+	{0x1D167, 0x1D169, 0},   // Zinh
+	{0x1D16A, 0x1D17A, 0},   // Zyyy
+	{0x1D17B, 0x1D182, 0},   // Zinh
+	{0x1D183, 0x1D184, 0},   // Zyyy
+	{0x1D185, 0x1D18B, 0},   // Zinh
+	{0x1D18C, 0x1D1A9, 0},   // Zyyy
+	{0x1D1AA, 0x1D1AD, 0},   // Zinh
+	{0x1D1AE, 0x1D1EA, 0},   // Zyyy
+	{0x1D200, 0x1D245, 3},   // Grek
+	{0x1D2C0, 0x1D2D3, 0},   // Zyyy
+	{0x1D2E0, 0x1D2F3, 0},   // Zyyy
+	{0x1D300, 0x1D356, 0},   // Zyyy
+	{0x1D360, 0x1D371, 90},  // Hani Hanb Jpan Kore
+	{0x1D372, 0x1D378, 0},   // Zyyy
+	{0x1D400, 0x1D454, 0},   // Zyyy
+	{0x1D456, 0x1D49C, 0},   // Zyyy
+	{0x1D49E, 0x1D49F, 0},   // Zyyy
+	{0x1D4A2, 0x1D4A2, 0},   // Zyyy
+	{0x1D4A5, 0x1D4A6, 0},   // Zyyy
+	{0x1D4A9, 0x1D4AC, 0},   // Zyyy
+	{0x1D4AE, 0x1D4B9, 0},   // Zyyy
+	{0x1D4BB, 0x1D4BB, 0},   // Zyyy
+	{0x1D4BD, 0x1D4C3, 0},   // Zyyy
+	{0x1D4C5, 0x1D505, 0},   // Zyyy
+	{0x1D507, 0x1D50A, 0},   // Zyyy
+	{0x1D50D, 0x1D514, 0},   // Zyyy
+	{0x1D516, 0x1D51C, 0},   // Zyyy
+	{0x1D51E, 0x1D539, 0},   // Zyyy
+	{0x1D53B, 0x1D53E, 0},   // Zyyy
+	{0x1D540, 0x1D544, 0},   // Zyyy
+	{0x1D546, 0x1D546, 0},   // Zyyy
+	{0x1D54A, 0x1D550, 0},   // Zyyy
+	{0x1D552, 0x1D6A5, 0},   // Zyyy
+	{0x1D6A8, 0x1D7CB, 0},   // Zyyy
+	{0x1D7CE, 0x1D7FF, 0},   // Zyyy
+	{0x1D800, 0x1DA8B, 209}, // Sgnw
+	{0x1DA9B, 0x1DA9F, 209}, // Sgnw
+	{0x1DAA1, 0x1DAAF, 209}, // Sgnw
+	{0x1DF00, 0x1DF1E, 1},   // Latn
+	{0x1DF25, 0x1DF2A, 1},   // Latn
+	{0x1E000, 0x1E006, 88},  // Glag
+	{0x1E008, 0x1E018, 88},  // Glag
+	{0x1E01B, 0x1E021, 88},  // Glag
+	{0x1E023, 0x1E024, 88},  // Glag
+	{0x1E026, 0x1E02A, 88},  // Glag
+	{0x1E030, 0x1E06D, 5},   // Cyrl
+	{0x1E08F, 0x1E08F, 5},   // Cyrl
+	{0x1E100, 0x1E12C, 210}, // Hmnp
+	{0x1E130, 0x1E13D, 210}, // Hmnp
+	{0x1E140, 0x1E149, 210}, // Hmnp
+	{0x1E14E, 0x1E14F, 210}, // Hmnp
+	{0x1E290, 0x1E2AE, 211}, // Toto
+	{0x1E2C0, 0x1E2F9, 212}, // Wcho
+	{0x1E2FF, 0x1E2FF, 212}, // Wcho
+	{0x1E4D0, 0x1E4F9, 213}, // Nagm
+	{0x1E7E0, 0x1E7E6, 52},  // Ethi
+	{0x1E7E8, 0x1E7EB, 52},  // Ethi
+	{0x1E7ED, 0x1E7EE, 52},  // Ethi
+	{0x1E7F0, 0x1E7FE, 52},  // Ethi
+	{0x1E800, 0x1E8C4, 214}, // Mend
+	{0x1E8C7, 0x1E8D6, 214}, // Mend
+	{0x1E900, 0x1E94B, 215}, // Adlm
+	{0x1E950, 0x1E959, 215}, // Adlm
+	{0x1E95E, 0x1E95F, 215}, // Adlm
+	{0x1EC71, 0x1ECB4, 0},   // Zyyy
+	{0x1ED01, 0x1ED3D, 0},   // Zyyy
+	{0x1EE00, 0x1EE03, 11},  // Arab
+	{0x1EE05, 0x1EE1F, 11},  // Arab
+	{0x1EE21, 0x1EE22, 11},  // Arab
+	{0x1EE24, 0x1EE24, 11},  // Arab
+	{0x1EE27, 0x1EE27, 11},  // Arab
+	{0x1EE29, 0x1EE32, 11},  // Arab
+	{0x1EE34, 0x1EE37, 11},  // Arab
+	{0x1EE39, 0x1EE39, 11},  // Arab
+	{0x1EE3B, 0x1EE3B, 11},  // Arab
+	{0x1EE42, 0x1EE42, 11},  // Arab
+	{0x1EE47, 0x1EE47, 11},  // Arab
+	{0x1EE49, 0x1EE49, 11},  // Arab
+	{0x1EE4B, 0x1EE4B, 11},  // Arab
+	{0x1EE4D, 0x1EE4F, 11},  // Arab
+	{0x1EE51, 0x1EE52, 11},  // Arab
+	{0x1EE54, 0x1EE54, 11},  // Arab
+	{0x1EE57, 0x1EE57, 11},  // Arab
+	{0x1EE59, 0x1EE59, 11},  // Arab
+	{0x1EE5B, 0x1EE5B, 11},  // Arab
+	{0x1EE5D, 0x1EE5D, 11},  // Arab
+	{0x1EE5F, 0x1EE5F, 11},  // Arab
+	{0x1EE61, 0x1EE62, 11},  // Arab
+	{0x1EE64, 0x1EE64, 11},  // Arab
+	{0x1EE67, 0x1EE6A, 11},  // Arab
+	{0x1EE6C, 0x1EE72, 11},  // Arab
+	{0x1EE74, 0x1EE77, 11},  // Arab
+	{0x1EE79, 0x1EE7C, 11},  // Arab
+	{0x1EE7E, 0x1EE7E, 11},  // Arab
+	{0x1EE80, 0x1EE89, 11},  // Arab
+	{0x1EE8B, 0x1EE9B, 11},  // Arab
+	{0x1EEA1, 0x1EEA3, 11},  // Arab
+	{0x1EEA5, 0x1EEA9, 11},  // Arab
+	{0x1EEAB, 0x1EEBB, 11},  // Arab
+	{0x1EEF0, 0x1EEF1, 11},  // Arab
+	{0x1F000, 0x1F02B, 0},   // Zyyy
+	{0x1F030, 0x1F093, 0},   // Zyyy
+	{0x1F0A0, 0x1F0AE, 0},   // Zyyy
+	{0x1F0B1, 0x1F0BF, 0},   // Zyyy
+	{0x1F0C1, 0x1F0CF, 0},   // Zyyy
+	{0x1F0D1, 0x1F0F5, 0},   // Zyyy
+	{0x1F100, 0x1F1AD, 0},   // Zyyy
+	{0x1F1E6, 0x1F1FF, 0},   // Zyyy
+	{0x1F200, 0x1F200, 96},  // Hira Jpan
+	{0x1F201, 0x1F202, 0},   // Zyyy
+	{0x1F210, 0x1F23B, 0},   // Zyyy
+	{0x1F240, 0x1F248, 0},   // Zyyy
+	{0x1F250, 0x1F251, 90},  // Hani Hanb Jpan Kore
+	{0x1F260, 0x1F265, 0},   // Zyyy
+	{0x1F300, 0x1F6D7, 0},   // Zyyy
+	{0x1F6DC, 0x1F6EC, 0},   // Zyyy
+	{0x1F6F0, 0x1F6FC, 0},   // Zyyy
+	{0x1F700, 0x1F776, 0},   // Zyyy
+	{0x1F77B, 0x1F7D9, 0},   // Zyyy
+	{0x1F7E0, 0x1F7EB, 0},   // Zyyy
+	{0x1F7F0, 0x1F7F0, 0},   // Zyyy
+	{0x1F800, 0x1F80B, 0},   // Zyyy
+	{0x1F810, 0x1F847, 0},   // Zyyy
+	{0x1F850, 0x1F859, 0},   // Zyyy
+	{0x1F860, 0x1F887, 0},   // Zyyy
+	{0x1F890, 0x1F8AD, 0},   // Zyyy
+	{0x1F8B0, 0x1F8B1, 0},   // Zyyy
+	{0x1F900, 0x1FA53, 0},   // Zyyy
+	{0x1FA60, 0x1FA6D, 0},   // Zyyy
+	{0x1FA70, 0x1FA7C, 0},   // Zyyy
+	{0x1FA80, 0x1FA88, 0},   // Zyyy
+	{0x1FA90, 0x1FABD, 0},   // Zyyy
+	{0x1FABF, 0x1FAC5, 0},   // Zyyy
+	{0x1FACE, 0x1FADB, 0},   // Zyyy
+	{0x1FAE0, 0x1FAE8, 0},   // Zyyy
+	{0x1FAF0, 0x1FAF8, 0},   // Zyyy
+	{0x1FB00, 0x1FB92, 0},   // Zyyy
+	{0x1FB94, 0x1FBCA, 0},   // Zyyy
+	{0x1FBF0, 0x1FBF9, 0},   // Zyyy
+	{0x20000, 0x2A6DF, 90},  // Hani Hanb Jpan Kore
+	{0x2A700, 0x2B739, 90},  // Hani Hanb Jpan Kore
+	{0x2B740, 0x2B81D, 90},  // Hani Hanb Jpan Kore
+	{0x2B820, 0x2CEA1, 90},  // Hani Hanb Jpan Kore
+	{0x2CEB0, 0x2EBE0, 90},  // Hani Hanb Jpan Kore
+	{0x2F800, 0x2FA1D, 90},  // Hani Hanb Jpan Kore
+	{0x30000, 0x3134A, 90},  // Hani Hanb Jpan Kore
+	{0x31350, 0x323AF, 90},  // Hani Hanb Jpan Kore
+	{0xE0001, 0xE0001, 0},   // Zyyy
+	{0xE0020, 0xE007F, 0},   // Zyyy
+	{0xE0100, 0xE01EF, 0},   // Zinh
+}
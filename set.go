@@ -0,0 +1,230 @@
+package blanknode
+
+import (
+	"encoding"
+	"encoding/json"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Set is a set of blank-node-identifiers.
+//
+// Because the elements are [Identifier]s (rather than strings), there is no question of whether an element is stored as "_:b0" or as "b0".
+//
+// [NoIdentifier] cannot be an element of a Set.
+//
+// The zero value of Set is an empty set, ready to use.
+//
+// Iterating through a Set (with [Set.All]) is always in the order of [Compare].
+//
+// For example:
+//
+//	var set blanknode.Set
+//
+//	err := set.Add(identifier)
+//
+//	// ...
+//
+//	for identifier := range set.All() {
+//		// ...
+//	}
+type Set struct {
+	identifiers map[Identifier]struct{}
+}
+
+var (
+	_ encoding.TextMarshaler   = Set{}
+	_ encoding.TextUnmarshaler = &Set{}
+	_ json.Marshaler           = Set{}
+	_ json.Unmarshaler         = &Set{}
+)
+
+// Add adds blank-node-identifiers to the set.
+//
+// Add returns [ErrEmptyIdentifier] (and adds nothing) if any of the blank-node-identifiers is [NoIdentifier].
+func (receiver *Set) Add(identifiers ...Identifier) error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	for _, identifier := range identifiers {
+		if identifier.IsNothing() {
+			return ErrEmptyIdentifier
+		}
+	}
+
+	if nil == receiver.identifiers {
+		receiver.identifiers = map[Identifier]struct{}{}
+	}
+
+	for _, identifier := range identifiers {
+		receiver.identifiers[identifier] = struct{}{}
+	}
+
+	return nil
+}
+
+// All returns an iterator over the blank-node-identifiers in the set, in the order of [Compare].
+func (receiver Set) All() iter.Seq[Identifier] {
+	var identifiers []Identifier = receiver.sorted()
+
+	return slices.Values(identifiers)
+}
+
+// Delete removes a blank-node-identifier from the set.
+func (receiver *Set) Delete(identifier Identifier) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	delete(receiver.identifiers, identifier)
+}
+
+// Difference returns a new set with the blank-node-identifiers that are in the set but not in ‘other’.
+func (receiver Set) Difference(other Set) Set {
+	var result Set
+
+	for identifier := range receiver.identifiers {
+		if !other.Has(identifier) {
+			result.add(identifier)
+		}
+	}
+
+	return result
+}
+
+// Has returns whether a blank-node-identifier is in the set.
+func (receiver Set) Has(identifier Identifier) bool {
+	_, found := receiver.identifiers[identifier]
+	return found
+}
+
+// Intersection returns a new set with the blank-node-identifiers that are in both the set and ‘other’.
+func (receiver Set) Intersection(other Set) Set {
+	var result Set
+
+	for identifier := range receiver.identifiers {
+		if other.Has(identifier) {
+			result.add(identifier)
+		}
+	}
+
+	return result
+}
+
+// Len returns the number of blank-node-identifiers in the set.
+func (receiver Set) Len() int {
+	return len(receiver.identifiers)
+}
+
+// MarshalJSON makes [Set] fit [json.Marshaler].
+//
+// A set is marshaled as a JSON array of blank-node-identifiers, in the order of [Compare].
+// For example:
+//
+//	["_:b0","_:b1","_:b10","_:b2"]
+func (receiver Set) MarshalJSON() ([]byte, error) {
+	var strs []string = []string{}
+	for identifier := range receiver.All() {
+		strs = append(strs, identifier.String())
+	}
+
+	return json.Marshal(strs)
+}
+
+// MarshalText makes [Set] fit [encoding.TextMarshaler].
+//
+// A set is marshaled as its blank-node-identifiers, separated by a space, in the order of [Compare].
+// For example:
+//
+//	_:b0 _:b1 _:b10 _:b2
+func (receiver Set) MarshalText() ([]byte, error) {
+	var buffer []byte
+	for identifier := range receiver.All() {
+		if 0 < len(buffer) {
+			buffer = append(buffer, ' ')
+		}
+		buffer = append(buffer, identifier.String()...)
+	}
+
+	return buffer, nil
+}
+
+// Union returns a new set with the blank-node-identifiers that are in either the set or ‘other’ (or both).
+func (receiver Set) Union(other Set) Set {
+	var result Set
+
+	for identifier := range receiver.identifiers {
+		result.add(identifier)
+	}
+	for identifier := range other.identifiers {
+		result.add(identifier)
+	}
+
+	return result
+}
+
+// UnmarshalJSON makes [Set] fit [json.Unmarshaler].
+//
+// See [Set.MarshalJSON] for the format.
+func (receiver *Set) UnmarshalJSON(data []byte) error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	var identifiers []Identifier
+	if err := json.Unmarshal(data, &identifiers); nil != err {
+		return err
+	}
+
+	var result Set
+	if err := result.Add(identifiers...); nil != err {
+		return err
+	}
+
+	*receiver = result
+	return nil
+}
+
+// UnmarshalText makes [Set] fit [encoding.TextUnmarshaler].
+//
+// The blank-node-identifiers can be separated by any whitespace.
+// See [Set.MarshalText] for the format.
+func (receiver *Set) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	var result Set
+
+	for _, field := range strings.Fields(string(text)) {
+		identifier, err := ParseIdentifierString(field)
+		if nil != err {
+			return err
+		}
+
+		result.add(identifier)
+	}
+
+	*receiver = result
+	return nil
+}
+
+func (receiver *Set) add(identifier Identifier) {
+	if nil == receiver.identifiers {
+		receiver.identifiers = map[Identifier]struct{}{}
+	}
+
+	receiver.identifiers[identifier] = struct{}{}
+}
+
+func (receiver Set) sorted() []Identifier {
+	var identifiers []Identifier = make([]Identifier, 0, len(receiver.identifiers))
+	for identifier := range receiver.identifiers {
+		identifiers = append(identifiers, identifier)
+	}
+	SortIdentifiers(identifiers)
+
+	return identifiers
+}
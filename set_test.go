@@ -0,0 +1,255 @@
+package blanknode
+
+import (
+	"testing"
+
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+func setTestSet(t *testing.T, labels ...string) Set {
+	var set Set
+	for _, label := range labels {
+		if err := set.Add(someIdentifier(someLabel(label))); nil != err {
+			t.Fatalf("Did not expect an error when adding %q, but actually got one: %s", label, err)
+		}
+	}
+
+	return set
+}
+
+func TestSet_Add_noIdentifier(t *testing.T) {
+	var set Set
+
+	err := set.Add(someIdentifier(someLabel("b0")), NoIdentifier())
+	if !errors.Is(err, ErrEmptyIdentifier) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED-ERROR: %s", ErrEmptyIdentifier)
+		t.Logf("ACTUAL-ERROR:   %s", err)
+	}
+
+	if expected, actual := 0, set.Len(); expected != actual {
+		t.Errorf("The actual length of the set is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+}
+
+func TestSet_operations(t *testing.T) {
+	a := setTestSet(t, "b10", "b2", "b0", "x")
+	b := setTestSet(t, "b2", "y", "b10", "b1")
+
+	tests := []struct {
+		Name     string
+		Set      Set
+		Expected string
+	}{
+		{
+			Name:     "a",
+			Set:      a,
+			Expected: `["_:b0","_:b10","_:b2","_:x"]`,
+		},
+		{
+			Name:     "a ∪ b",
+			Set:      a.Union(b),
+			Expected: `["_:b0","_:b1","_:b10","_:b2","_:x","_:y"]`,
+		},
+		{
+			Name:     "a ∩ b",
+			Set:      a.Intersection(b),
+			Expected: `["_:b10","_:b2"]`,
+		},
+		{
+			Name:     "a − b",
+			Set:      a.Difference(b),
+			Expected: `["_:b0","_:x"]`,
+		},
+		{
+			Name:     "b − a",
+			Set:      b.Difference(a),
+			Expected: `["_:b1","_:y"]`,
+		},
+		{
+			Name:     "∅",
+			Set:      Set{},
+			Expected: `[]`,
+		},
+	}
+
+	for testNumber, test := range tests {
+		actualJSON, err := test.Set.MarshalJSON()
+		if nil != err {
+			t.Errorf("For test #%d (%s), did not expect an error, but actually got one.", testNumber, test.Name)
+			t.Logf("ERROR: %s", err)
+			continue
+		}
+
+		{
+			expected := test.Expected
+			actual := string(actualJSON)
+
+			if expected != actual {
+				t.Errorf("For test #%d (%s), the actual JSON is not what was expected.", testNumber, test.Name)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				continue
+			}
+		}
+	}
+}
+
+func TestSet_MarshalText(t *testing.T) {
+	set := setTestSet(t, "b10", "b2", "b0")
+
+	actual, err := set.MarshalText()
+	if nil != err {
+		t.Errorf("Did not expect an error, but actually got one.")
+		t.Logf("ERROR: %s", err)
+		return
+	}
+
+	if expected := "_:b0 _:b10 _:b2"; expected != string(actual) {
+		t.Errorf("The actual text is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+func TestSet_All(t *testing.T) {
+	set := setTestSet(t, "b10", "b2", "b0", "b1")
+
+	expected := []Identifier{
+		someIdentifier(someLabel("b0")),
+		someIdentifier(someLabel("b1")),
+		someIdentifier(someLabel("b10")),
+		someIdentifier(someLabel("b2")),
+	}
+	actual := slices.Collect(set.All())
+
+	if !slices.Equal(expected, actual) {
+		t.Errorf("The actual blank-node-identifiers are not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+func TestSet_unmarshal(t *testing.T) {
+	expected := setTestSet(t, "b10", "b2", "b0", "日本")
+
+	{
+		data, err := expected.MarshalJSON()
+		if nil != err {
+			t.Fatalf("Did not expect an error, but actually got one: %s", err)
+		}
+
+		var actual Set
+		if err := actual.UnmarshalJSON(data); nil != err {
+			t.Errorf("Did not expect an error, but actually got one.")
+			t.Logf("ERROR: %s", err)
+			return
+		}
+
+		if !slices.Equal(slices.Collect(expected.All()), slices.Collect(actual.All())) {
+			t.Errorf("The actual set (from JSON) is not what was expected.")
+			t.Logf("EXPECTED: %q", slices.Collect(expected.All()))
+			t.Logf("ACTUAL:   %q", slices.Collect(actual.All()))
+		}
+	}
+
+	{
+		var actual Set
+		if err := actual.UnmarshalText([]byte(" _:b0\n_:b10\t_:b2  _:日本 _:b0 ")); nil != err {
+			t.Errorf("Did not expect an error, but actually got one.")
+			t.Logf("ERROR: %s", err)
+			return
+		}
+
+		if !slices.Equal(slices.Collect(expected.All()), slices.Collect(actual.All())) {
+			t.Errorf("The actual set (from text) is not what was expected.")
+			t.Logf("EXPECTED: %q", slices.Collect(expected.All()))
+			t.Logf("ACTUAL:   %q", slices.Collect(actual.All()))
+		}
+	}
+
+	for _, data := range []string{`["b0"]`, `["_:-b0"]`, `[""]`} {
+		var actual Set
+		if err := actual.UnmarshalJSON([]byte(data)); nil == err {
+			t.Errorf("For %s, expected an error, but did not actually get one.", data)
+		}
+	}
+}
+
+func TestSet_roundTrip(t *testing.T) {
+	tests := []struct {
+		Labels []string
+	}{
+		{},
+		{
+			Labels: []string{"b0"},
+		},
+		{
+			Labels: []string{"b10", "b2", "b0", "b1"},
+		},
+		{
+			Labels: []string{"日本", "a.b", "_x", "0-9", "a·̀ͯ‿.⁀"},
+		},
+	}
+
+	for testNumber, test := range tests {
+		expected := setTestSet(t, test.Labels...)
+
+		{
+			text, err := expected.MarshalText()
+			if nil != err {
+				t.Errorf("For test #%d, did not expect an error from MarshalText, but actually got one.", testNumber)
+				t.Logf("ERROR: %s", err)
+				continue
+			}
+
+			var actual Set
+			if err := actual.UnmarshalText(text); nil != err {
+				t.Errorf("For test #%d, did not expect an error from UnmarshalText, but actually got one.", testNumber)
+				t.Logf("TEXT: %q", text)
+				t.Logf("ERROR: %s", err)
+				continue
+			}
+
+			if !slices.Equal(slices.Collect(expected.All()), slices.Collect(actual.All())) {
+				t.Errorf("For test #%d, the actual set (from text) is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", slices.Collect(expected.All()))
+				t.Logf("ACTUAL:   %q", slices.Collect(actual.All()))
+				continue
+			}
+		}
+
+		{
+			type document struct {
+				Set Set `json:"set"`
+			}
+
+			data, err := json.Marshal(document{Set: expected})
+			if nil != err {
+				t.Errorf("For test #%d, did not expect an error from json.Marshal, but actually got one.", testNumber)
+				t.Logf("ERROR: %s", err)
+				continue
+			}
+
+			var actual document
+			if err := json.Unmarshal(data, &actual); nil != err {
+				t.Errorf("For test #%d, did not expect an error from json.Unmarshal, but actually got one.", testNumber)
+				t.Logf("JSON: %s", data)
+				t.Logf("ERROR: %s", err)
+				continue
+			}
+
+			if !slices.Equal(slices.Collect(expected.All()), slices.Collect(actual.Set.All())) {
+				t.Errorf("For test #%d, the actual set (from JSON) is not what was expected.", testNumber)
+				t.Logf("JSON: %s", data)
+				t.Logf("EXPECTED: %q", slices.Collect(expected.All()))
+				t.Logf("ACTUAL:   %q", slices.Collect(actual.Set.All()))
+				continue
+			}
+		}
+	}
+}
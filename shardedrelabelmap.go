@@ -0,0 +1,98 @@
+package blanknode
+
+import (
+	"hash/maphash"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+var _ RelabelMap = &ShardedRelabelMap{}
+
+// ShardedRelabelMap is a [RelabelMap] for relabeling from many goroutines at the same time.
+// For example, relabeling a dump that is split up into shards, each processed by its own goroutine —
+// where a blank-node-identifier must be relabeled to the same new blank-node-identifier in every shard.
+//
+// The blank-node-identifiers are split up among a number of (separately locked) shards, so goroutines relabeling different blank-node-identifiers rarely wait on each other.
+// The number of shards is based on GOMAXPROCS (see [runtime.GOMAXPROCS]) at the time of first use.
+//
+// The new blank-node-identifiers are "_:b0", "_:b1", "_:b2", etc.
+// Each blank-node-identifier gets a different number, but (unlike [MemoryRelabelMap]) the numbers depend on the order the goroutines happen to run in.
+//
+// The zero value is ready to use.
+type ShardedRelabelMap struct {
+	once   sync.Once
+	seed   maphash.Seed
+	shards []shardedRelabelMapShard
+	next   atomic.Uint64
+}
+
+type shardedRelabelMapShard struct {
+	mutex       sync.RWMutex
+	identifiers map[Identifier]Identifier
+
+	// Padding, so that shards next to each other (in memory) are not in the same cache-line.
+	_ [64]byte
+}
+
+func (receiver *ShardedRelabelMap) init() {
+	receiver.once.Do(func() {
+		var count int = 1
+		for count < 4*runtime.GOMAXPROCS(0) {
+			count *= 2
+		}
+
+		receiver.seed = maphash.MakeSeed()
+		receiver.shards = make([]shardedRelabelMapShard, count)
+		for index := range receiver.shards {
+			receiver.shards[index].identifiers = map[Identifier]Identifier{}
+		}
+	})
+}
+
+// Len returns how many blank-node-identifiers have been relabeled.
+func (receiver *ShardedRelabelMap) Len() int {
+	if nil == receiver {
+		return 0
+	}
+
+	return int(receiver.next.Load())
+}
+
+// Relabel returns the new blank-node-identifier for ‘identifier’, assigning one if ‘identifier’ has not been relabeled before.
+//
+// It is safe to call from multiple goroutines.
+func (receiver *ShardedRelabelMap) Relabel(identifier Identifier) (Identifier, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+
+	label, found := identifier.Get()
+	if !found {
+		return Identifier{}, ErrEmptyIdentifier
+	}
+
+	receiver.init()
+
+	var shard *shardedRelabelMapShard = &receiver.shards[maphash.String(receiver.seed, label)&uint64(len(receiver.shards)-1)]
+
+	shard.mutex.RLock()
+	relabeled, found := shard.identifiers[identifier]
+	shard.mutex.RUnlock()
+	if found {
+		return relabeled, nil
+	}
+
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+
+	// Another goroutine might have relabeled it between the locks.
+	if relabeled, found := shard.identifiers[identifier]; found {
+		return relabeled, nil
+	}
+
+	relabeled = relabelMapIdentifier(receiver.next.Add(1) - 1)
+	shard.identifiers[identifier] = relabeled
+
+	return relabeled, nil
+}
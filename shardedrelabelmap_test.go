@@ -0,0 +1,126 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// TestShardedRelabelMap is meant to also be run with the race-detector:
+//
+//	go test -race -run ShardedRelabelMap
+func TestShardedRelabelMap(t *testing.T) {
+	const goroutines = 64
+	const count = 5000
+
+	var relabelMap ShardedRelabelMap
+
+	// results[g][i] is what goroutine ‘g’ relabeled "_:x{i}" to.
+	var results [goroutines][]Identifier
+
+	var waitGroup sync.WaitGroup
+	var errs [goroutines]error
+	for g := 0; g < goroutines; g++ {
+		waitGroup.Add(1)
+		go func(g int) {
+			defer waitGroup.Done()
+
+			results[g] = make([]Identifier, count)
+
+			// Each goroutine goes through the blank-node-identifiers in a different order.
+			for n := 0; n < count; n++ {
+				var i int = (n + g*997) % count
+
+				relabeled, err := relabelMap.Relabel(someIdentifier(someLabel("x" + strconv.Itoa(i))))
+				if nil != err {
+					errs[g] = err
+					return
+				}
+				results[g][i] = relabeled
+			}
+		}(g)
+	}
+	waitGroup.Wait()
+
+	for g, err := range errs {
+		if nil != err {
+			t.Errorf("For goroutine #%d, did not expect an error but actually got one.", g)
+			t.Logf("ERROR: (%T) %s", err, err)
+			return
+		}
+	}
+
+	// Every goroutine relabeled each blank-node-identifier the same way.
+	for g := 1; g < goroutines; g++ {
+		for i := 0; i < count; i++ {
+			if results[0][i] != results[g][i] {
+				t.Errorf("For goroutine #%d, the actual relabeled blank-node-identifier for _:x%d is not what was expected.", g, i)
+				t.Logf("EXPECTED: %s", results[0][i])
+				t.Logf("ACTUAL:   %s", results[g][i])
+				return
+			}
+		}
+	}
+
+	// Different blank-node-identifiers were relabeled differently, to "_:b0" through "_:b{count-1}".
+	{
+		var seen map[Identifier]struct{} = map[Identifier]struct{}{}
+		for _, relabeled := range results[0] {
+			seen[relabeled] = struct{}{}
+		}
+		for n := 0; n < count; n++ {
+			identifier := someIdentifier(someLabel("b" + strconv.Itoa(n)))
+			if _, found := seen[identifier]; !found {
+				t.Errorf("Expected %s to have been assigned, but it actually was not.", identifier)
+				return
+			}
+		}
+	}
+
+	if expected, actual := count, relabelMap.Len(); expected != actual {
+		t.Errorf("The actual length is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+
+	if _, err := relabelMap.Relabel(NoIdentifier()); !errors.Is(err, ErrEmptyIdentifier) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrEmptyIdentifier)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func benchmarkRelabelMap(b *testing.B, relabelMap RelabelMap, goroutines int) {
+	// A mix of blank-node-identifiers that are seen over and over again, and ones that are new.
+	const distinct = 1 << 16
+
+	var identifiers []Identifier = make([]Identifier, distinct)
+	for i := range identifiers {
+		identifiers[i] = someIdentifier(someLabel("x" + strconv.Itoa(i)))
+	}
+
+	b.ResetTimer()
+
+	var waitGroup sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		waitGroup.Add(1)
+		go func(g int) {
+			defer waitGroup.Done()
+
+			for n := g; n < b.N; n += goroutines {
+				relabelMap.Relabel(identifiers[(n*7919)%distinct])
+			}
+		}(g)
+	}
+	waitGroup.Wait()
+}
+
+func BenchmarkShardedRelabelMap_64goroutines(b *testing.B) {
+	benchmarkRelabelMap(b, new(ShardedRelabelMap), 64)
+}
+
+func BenchmarkMemoryRelabelMap_64goroutines(b *testing.B) {
+	benchmarkRelabelMap(b, new(MemoryRelabelMap), 64)
+}
@@ -0,0 +1,279 @@
+package blanknode
+
+import (
+	"sync"
+	"time"
+
+	"codeberg.org/reiver/go-erorr"
+)
+
+// SnowflakeLabelPrefix is the prefix at the beginning of all blank-node-labels generated by [SnowflakeGenerator].
+const SnowflakeLabelPrefix string = "s"
+
+// SnowflakeEpoch is the time that the timestamps of [SnowflakeGenerator] are counted from — 2020-01-01T00:00:00Z.
+//
+// (The timestamps are 41 bits of milliseconds, so they run out about 69 years after this.
+// After that, [SnowflakeGenerator] returns an error that wraps [ErrSnowflakeExhausted].)
+var SnowflakeEpoch time.Time = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+const (
+	snowflakeTimestampBits = 41
+	snowflakeWorkerIDBits  = 10
+	snowflakeSequenceBits  = 12
+
+	// MaxSnowflakeWorkerID is the largest worker-ID a [SnowflakeGenerator] can have.
+	MaxSnowflakeWorkerID = 1<<snowflakeWorkerIDBits - 1
+
+	maxSnowflakeTimestamp = 1<<snowflakeTimestampBits - 1
+	maxSnowflakeSequence  = 1<<snowflakeSequenceBits - 1
+
+	// 13 characters of base32 hold 65 bits.
+	snowflakeLabelDigits = 13
+
+	// Crockford's base32, in lowercase.
+	// The characters are in ASCII order, so (since the number of characters is fixed) the blank-node-labels sort in the same order as the numbers.
+	snowflakeAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+)
+
+// SnowflakeClockSkewPolicy is what a [SnowflakeGenerator] does when the clock goes backwards.
+type SnowflakeClockSkewPolicy int
+
+const (
+	// SnowflakeClockSkewWait waits for the clock to catch up — unless that would be longer than ‘MaxClockSkew’, in which case it is an error.
+	// This is the default.
+	SnowflakeClockSkewWait SnowflakeClockSkewPolicy = iota
+
+	// SnowflakeClockSkewError returns an error that wraps [ErrClockSkew].
+	SnowflakeClockSkewError
+
+	// SnowflakeClockSkewContinue keeps going from the last timestamp it used (as if the clock had not gone backwards), until the clock catches up.
+	// The timestamps in the blank-node-labels will be (a little) off, but it never waits and never errors.
+	SnowflakeClockSkewContinue
+)
+
+// SnowflakeParts are the parts of a blank-node-label generated by [SnowflakeGenerator].
+//
+// See [DecodeSnowflakeLabel].
+type SnowflakeParts struct {
+	Time     time.Time
+	WorkerID uint16
+	Sequence uint16
+}
+
+// SnowflakeGenerator generates blank-node-labels that are unique across many workers (for example, on different machines) without any coordination between them —
+// in the style of Twitter's Snowflake IDs.
+//
+// Each blank-node-label is made from a 64-bit number:
+//
+// • 1 bit that is always 0,
+//
+// • 41 bits of timestamp (milliseconds since [SnowflakeEpoch]),
+//
+// • 10 bits of worker-ID, and
+//
+// • 12 bits of sequence number (for more than one blank-node-label in the same millisecond).
+//
+// The blank-node-label is "s" followed by 13 characters of (lowercase) Crockford base32 of the number.
+// For example:
+//
+//	s0rz0ys6000w00
+//
+// Since the timestamp comes first, the blank-node-labels sort (roughly) by the time they were generated.
+//
+// Each worker must be given a different ‘WorkerID’.
+//
+// It is safe to use from multiple goroutines.
+type SnowflakeGenerator struct {
+	// WorkerID identifies the worker.
+	// It must be from 0 to [MaxSnowflakeWorkerID].
+	WorkerID uint16
+
+	// ClockSkew is what to do when the clock goes backwards.
+	ClockSkew SnowflakeClockSkewPolicy
+
+	// MaxClockSkew is the longest [SnowflakeClockSkewWait] will wait.
+	// If it is zero, then 1 second is used.
+	MaxClockSkew time.Duration
+
+	// Now returns the current time.
+	// If it is nil, then [time.Now] is used.
+	Now func() time.Time
+
+	sleep func(time.Duration)
+
+	mutex    sync.Mutex
+	last     int64
+	sequence uint64
+	started  bool
+}
+
+// Identifier returns a new blank-node-identifier.
+func (receiver *SnowflakeGenerator) Identifier() (Identifier, error) {
+	label, err := receiver.Label()
+	if nil != err {
+		return Identifier{}, err
+	}
+
+	return someIdentifier(label), nil
+}
+
+// Label returns a new blank-node-label.
+func (receiver *SnowflakeGenerator) Label() (Label, error) {
+	if nil == receiver {
+		panic(ErrNilReceiver)
+	}
+	if MaxSnowflakeWorkerID < receiver.WorkerID {
+		return Label{}, erorr.Errorf("snowflake worker-id %d: %w", receiver.WorkerID, ErrWorkerIDOutOfRange)
+	}
+
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	now, err := receiver.timestamp()
+	if nil != err {
+		return Label{}, err
+	}
+
+	switch {
+	case !receiver.started || receiver.last < now:
+		receiver.last = now
+		receiver.sequence = 0
+	default:
+		// The same millisecond (or the clock went backwards, and ‘ClockSkew’ is SnowflakeClockSkewContinue).
+		receiver.sequence++
+		if maxSnowflakeSequence < receiver.sequence {
+			// Out of sequence numbers for this millisecond.
+			if SnowflakeClockSkewContinue == receiver.ClockSkew && now < receiver.last {
+				receiver.last++
+			} else {
+				for now <= receiver.last {
+					receiver.doSleep(time.Millisecond)
+					now, err = receiver.timestamp()
+					if nil != err {
+						return Label{}, err
+					}
+				}
+				receiver.last = now
+			}
+			receiver.sequence = 0
+		}
+	}
+	receiver.started = true
+
+	if maxSnowflakeTimestamp < receiver.last {
+		return Label{}, erorr.Errorf("snowflake timestamp %d is more than %d bits: %w", receiver.last, snowflakeTimestampBits, ErrSnowflakeExhausted)
+	}
+
+	var number uint64 = uint64(receiver.last)<<(snowflakeWorkerIDBits+snowflakeSequenceBits) | uint64(receiver.WorkerID)<<snowflakeSequenceBits | receiver.sequence
+
+	return someLabel(snowflakeLabelString(number)), nil
+}
+
+// timestamp returns the current timestamp (milliseconds since SnowflakeEpoch), applying ‘ClockSkew’ if the clock went backwards.
+func (receiver *SnowflakeGenerator) timestamp() (int64, error) {
+	var now int64 = receiver.now()
+	if now < 0 {
+		return 0, erorr.Errorf("snowflake clock is before the epoch: %w", ErrClockSkew)
+	}
+	if !receiver.started || receiver.last <= now {
+		return now, nil
+	}
+
+	var skew time.Duration = time.Duration(receiver.last-now) * time.Millisecond
+
+	switch receiver.ClockSkew {
+	case SnowflakeClockSkewContinue:
+		return now, nil
+	case SnowflakeClockSkewError:
+		return 0, erorr.Errorf("snowflake clock went backwards by %s: %w", skew, ErrClockSkew)
+	default:
+		var max time.Duration = receiver.MaxClockSkew
+		if max <= 0 {
+			max = time.Second
+		}
+		if max < skew {
+			return 0, erorr.Errorf("snowflake clock went backwards by %s (more than %s): %w", skew, max, ErrClockSkew)
+		}
+
+		for now < receiver.last {
+			receiver.doSleep(time.Duration(receiver.last-now) * time.Millisecond)
+			now = receiver.now()
+		}
+		return now, nil
+	}
+}
+
+func (receiver *SnowflakeGenerator) now() int64 {
+	var now time.Time
+	if nil != receiver.Now {
+		now = receiver.Now()
+	} else {
+		now = time.Now()
+	}
+
+	return now.Sub(SnowflakeEpoch).Milliseconds()
+}
+
+func (receiver *SnowflakeGenerator) doSleep(duration time.Duration) {
+	if nil != receiver.sleep {
+		receiver.sleep(duration)
+		return
+	}
+
+	time.Sleep(duration)
+}
+
+func snowflakeLabelString(number uint64) string {
+	var buffer [len(SnowflakeLabelPrefix) + snowflakeLabelDigits]byte
+	copy(buffer[:], SnowflakeLabelPrefix)
+
+	for index := len(buffer) - 1; len(SnowflakeLabelPrefix) <= index; index-- {
+		buffer[index] = snowflakeAlphabet[number&31]
+		number >>= 5
+	}
+
+	return string(buffer[:])
+}
+
+// DecodeSnowflakeLabel returns the parts of a blank-node-label generated by [SnowflakeGenerator].
+//
+// It returns an error that wraps [ErrSnowflakeLabelMalformed] if ‘label’ is not such a blank-node-label.
+func DecodeSnowflakeLabel(label Label) (SnowflakeParts, error) {
+	str, found := label.Get()
+	if !found {
+		return SnowflakeParts{}, ErrEmptyLabel
+	}
+
+	if len(SnowflakeLabelPrefix)+snowflakeLabelDigits != len(str) || SnowflakeLabelPrefix != str[:len(SnowflakeLabelPrefix)] {
+		return SnowflakeParts{}, erorr.Errorf("blank-node-label %q: %w", str, ErrSnowflakeLabelMalformed)
+	}
+
+	var number uint64
+	for index, digits := 0, str[len(SnowflakeLabelPrefix):]; index < len(digits); index++ {
+		var value int = -1
+		for v := 0; v < len(snowflakeAlphabet); v++ {
+			if snowflakeAlphabet[v] == digits[index] {
+				value = v
+				break
+			}
+		}
+		if value < 0 {
+			return SnowflakeParts{}, erorr.Errorf("blank-node-label %q has character %q: %w", str, digits[index], ErrSnowflakeLabelMalformed)
+		}
+
+		// The top bits (the 65th bit, and the sign bit) must be 0.
+		if 0 == index && 8 <= value {
+			return SnowflakeParts{}, erorr.Errorf("blank-node-label %q is too large: %w", str, ErrSnowflakeLabelMalformed)
+		}
+
+		number = number<<5 | uint64(value)
+	}
+
+	var timestamp int64 = int64(number >> (snowflakeWorkerIDBits + snowflakeSequenceBits))
+
+	return SnowflakeParts{
+		Time:     SnowflakeEpoch.Add(time.Duration(timestamp) * time.Millisecond),
+		WorkerID: uint16((number >> snowflakeSequenceBits) & MaxSnowflakeWorkerID),
+		Sequence: uint16(number & maxSnowflakeSequence),
+	}, nil
+}
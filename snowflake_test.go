@@ -0,0 +1,345 @@
+package blanknode
+
+import (
+	"testing"
+
+	"errors"
+	"time"
+)
+
+// snowflakeTestClock is a fake clock, for testing.
+type snowflakeTestClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func (receiver *snowflakeTestClock) Now() time.Time {
+	return receiver.now
+}
+
+func (receiver *snowflakeTestClock) Sleep(duration time.Duration) {
+	receiver.slept += duration
+	receiver.now = receiver.now.Add(duration)
+}
+
+func snowflakeTestGenerator(clock *snowflakeTestClock, workerID uint16, policy SnowflakeClockSkewPolicy) *SnowflakeGenerator {
+	return &SnowflakeGenerator{
+		WorkerID:  workerID,
+		ClockSkew: policy,
+		Now:       clock.Now,
+		sleep:     clock.Sleep,
+	}
+}
+
+func TestSnowflakeGenerator(t *testing.T) {
+	var start time.Time = time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	var clock snowflakeTestClock = snowflakeTestClock{now: start}
+
+	generator := snowflakeTestGenerator(&clock, 7, SnowflakeClockSkewWait)
+
+	tests := []struct{
+		Advance          time.Duration
+		ExpectedLabel    string
+		ExpectedSequence uint16
+	}{
+		{
+			ExpectedLabel:    "s0rz0ys6000w00",
+			ExpectedSequence: 0,
+		},
+		{
+			ExpectedLabel:    "s0rz0ys6000w01",
+			ExpectedSequence: 1,
+		},
+		{
+			Advance:          time.Millisecond,
+			ExpectedLabel:    "s0rz0ys6040w00",
+			ExpectedSequence: 0,
+		},
+	}
+
+	var previous string
+	for testNumber, test := range tests {
+		clock.now = clock.now.Add(test.Advance)
+
+		label, err := generator.Label()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		{
+			expected := test.ExpectedLabel
+			actual := label.String()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual blank-node-label is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+			if actual <= previous {
+				t.Errorf("For test #%d, expected the blank-node-label to sort after the previous one, but it actually did not.", testNumber)
+				t.Logf("PREVIOUS: %q", previous)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+			previous = actual
+		}
+
+		if _, err := ParseLabelString(label.String()); nil != err {
+			t.Errorf("For test #%d, expected the blank-node-label to be valid, but it actually was not.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		parts, err := DecodeSnowflakeLabel(label)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		{
+			expected := SnowflakeParts{
+				Time:     clock.now,
+				WorkerID: 7,
+				Sequence: test.ExpectedSequence,
+			}
+			actual := parts
+
+			if !expected.Time.Equal(actual.Time) || expected.WorkerID != actual.WorkerID || expected.Sequence != actual.Sequence {
+				t.Errorf("For test #%d, the actual decoded parts are not what was expected.", testNumber)
+				t.Logf("EXPECTED: %#v", expected)
+				t.Logf("ACTUAL:   %#v", actual)
+				continue
+			}
+		}
+	}
+}
+
+func TestSnowflakeGenerator_differentWorkers(t *testing.T) {
+	var clock snowflakeTestClock = snowflakeTestClock{now: time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)}
+
+	a, err := snowflakeTestGenerator(&clock, 1, SnowflakeClockSkewWait).Label()
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+	b, err := snowflakeTestGenerator(&clock, 2, SnowflakeClockSkewWait).Label()
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+	}
+
+	if a == b {
+		t.Errorf("Expected different workers to generate different blank-node-labels, but they actually did not.")
+		t.Logf("A: %q", a)
+		t.Logf("B: %q", b)
+	}
+}
+
+func TestSnowflakeGenerator_sequenceOverflow(t *testing.T) {
+	var clock snowflakeTestClock = snowflakeTestClock{now: time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)}
+
+	generator := snowflakeTestGenerator(&clock, 0, SnowflakeClockSkewWait)
+
+	var seen map[Label]struct{} = map[Label]struct{}{}
+	for i := 0; i < 5000; i++ {
+		label, err := generator.Label()
+		if nil != err {
+			t.Fatalf("Did not expect an error but actually got one: (%T) %s", err, err)
+		}
+		if _, found := seen[label]; found {
+			t.Fatalf("Did not expect a duplicate blank-node-label, but actually got one: %q", label)
+		}
+		seen[label] = struct{}{}
+	}
+
+	// 4096 sequence numbers per millisecond, so it had to wait for the next millisecond once.
+	if expected, actual := time.Millisecond, clock.slept; expected != actual {
+		t.Errorf("The actual time slept is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+	}
+}
+
+func TestSnowflakeGenerator_clockSkew(t *testing.T) {
+	var start time.Time = time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct{
+		Policy        SnowflakeClockSkewPolicy
+		Backwards     time.Duration
+		ExpectedError bool
+		ExpectedSlept time.Duration
+	}{
+		{
+			Policy:        SnowflakeClockSkewWait,
+			Backwards:     5 * time.Millisecond,
+			ExpectedSlept: 5 * time.Millisecond,
+		},
+		{
+			Policy:        SnowflakeClockSkewWait,
+			Backwards:     time.Minute,
+			ExpectedError: true,
+		},
+		{
+			Policy:        SnowflakeClockSkewError,
+			Backwards:     5 * time.Millisecond,
+			ExpectedError: true,
+		},
+		{
+			Policy:        SnowflakeClockSkewContinue,
+			Backwards:     time.Minute,
+		},
+	}
+
+	for testNumber, test := range tests {
+		var clock snowflakeTestClock = snowflakeTestClock{now: start}
+		generator := snowflakeTestGenerator(&clock, 3, test.Policy)
+
+		first, err := generator.Label()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		clock.now = clock.now.Add(-test.Backwards)
+
+		second, err := generator.Label()
+		if test.ExpectedError {
+			if !errors.Is(err, ErrClockSkew) {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %s", ErrClockSkew)
+				t.Logf("ACTUAL:   %v", err)
+			}
+			continue
+		}
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if second.String() <= first.String() {
+			t.Errorf("For test #%d, expected the second blank-node-label to sort after the first, but it actually did not.", testNumber)
+			t.Logf("FIRST:  %q", first)
+			t.Logf("SECOND: %q", second)
+			continue
+		}
+
+		if expected, actual := test.ExpectedSlept, clock.slept; expected != actual {
+			t.Errorf("For test #%d, the actual time slept is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
+
+func TestSnowflakeGenerator_workerIDOutOfRange(t *testing.T) {
+	generator := SnowflakeGenerator{
+		WorkerID: MaxSnowflakeWorkerID + 1,
+	}
+
+	if _, err := generator.Label(); !errors.Is(err, ErrWorkerIDOutOfRange) {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", ErrWorkerIDOutOfRange)
+		t.Logf("ACTUAL:   %v", err)
+	}
+}
+
+func TestSnowflakeGenerator_exhausted(t *testing.T) {
+	var last time.Time = SnowflakeEpoch.Add(maxSnowflakeTimestamp * time.Millisecond)
+
+	{
+		clock := &snowflakeTestClock{now: last}
+		generator := snowflakeTestGenerator(clock, 7, SnowflakeClockSkewWait)
+
+		label, err := generator.Label()
+		if nil != err {
+			t.Errorf("Did not expect an error for the last timestamp, but actually got one.")
+			t.Logf("ERROR: %s", err)
+			return
+		}
+
+		parts, err := DecodeSnowflakeLabel(label)
+		if nil != err {
+			t.Errorf("Did not expect an error when decoding, but actually got one.")
+			t.Logf("ERROR: %s", err)
+			return
+		}
+		if !last.Equal(parts.Time) {
+			t.Errorf("The actual time is not what was expected.")
+			t.Logf("EXPECTED: %s", last)
+			t.Logf("ACTUAL:   %s", parts.Time)
+		}
+	}
+
+	{
+		clock := &snowflakeTestClock{now: last.Add(time.Millisecond)}
+		generator := snowflakeTestGenerator(clock, 7, SnowflakeClockSkewWait)
+
+		_, err := generator.Label()
+		if !errors.Is(err, ErrSnowflakeExhausted) {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED: %s", ErrSnowflakeExhausted)
+			t.Logf("ACTUAL:   %v", err)
+		}
+		if errors.Is(err, ErrClockSkew) {
+			t.Errorf("Did not expect the error to be ErrClockSkew, but it actually was.")
+			t.Logf("ERROR: %s", err)
+		}
+	}
+
+	// Running out of sequence numbers in the last millisecond.
+	{
+		clock := &snowflakeTestClock{now: last}
+		generator := snowflakeTestGenerator(clock, 7, SnowflakeClockSkewWait)
+
+		for count := 0; count <= maxSnowflakeSequence; count++ {
+			if _, err := generator.Label(); nil != err {
+				t.Fatalf("Did not expect an error for blank-node-label #%d, but actually got one: %s", count, err)
+			}
+		}
+
+		if _, err := generator.Label(); !errors.Is(err, ErrSnowflakeExhausted) {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED: %s", ErrSnowflakeExhausted)
+			t.Logf("ACTUAL:   %v", err)
+		}
+	}
+}
+
+func TestDecodeSnowflakeLabel_error(t *testing.T) {
+	tests := []struct{
+		Label string
+	}{
+		{
+			Label: "b0",
+		},
+		{
+			Label: "x0rz0ys6000w00",
+		},
+		{
+			Label: "s0rz0ys6000w0",
+		},
+		{
+			Label: "s0rz0ys6000wu",
+		},
+		{
+			Label: "s8000000000000",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := DecodeSnowflakeLabel(someLabel(test.Label))
+		if !errors.Is(err, ErrSnowflakeLabelMalformed) {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("LABEL:    %q", test.Label)
+			t.Logf("EXPECTED: %s", ErrSnowflakeLabelMalformed)
+			t.Logf("ACTUAL:   %v", err)
+			continue
+		}
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package blanknode
+
+// syncDirectory does nothing on this platform, since a directory cannot be fsync'ed here.
+// (On Windows, for example, a rename is made durable by the file-system without it.)
+func syncDirectory(path string) error {
+	return nil
+}
@@ -0,0 +1,22 @@
+//go:build unix
+
+package blanknode
+
+import (
+	"os"
+)
+
+// syncDirectory calls fsync on the directory at ‘path’, so that (for example) a rename in it is durable.
+func syncDirectory(path string) error {
+	directory, err := os.Open(path)
+	if nil != err {
+		return err
+	}
+
+	if err := directory.Sync(); nil != err {
+		directory.Close()
+		return err
+	}
+
+	return directory.Close()
+}